@@ -3,10 +3,13 @@ package bridgectrl
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
 )
 
 const (
@@ -14,13 +17,34 @@ const (
 	KeyLen = 32
 	// MainNetworkID is the chain ID for the main network
 	MainNetworkID = uint(0)
+	// defaultRollupNetworkID is the network of the rollup of the first version of the contracts
+	defaultRollupNetworkID = uint(1)
+	// verifiedRollupTreeIndex is the exit tree of the rollup whose batches are verified by the proof of efficiency
+	// contract synced from L1, the first rollup of the config
+	verifiedRollupTreeIndex = uint8(1)
 )
 
 // BridgeController struct
 type BridgeController struct {
-	exitTrees  []*MerkleTree
+	// lock protects the registered networks and their exit trees, which change when networks are registered at runtime
+	lock       sync.RWMutex
+	exitTrees  map[uint8]*MerkleTree
 	networkIDs map[uint]uint8
 	storage    bridgeStorage
+	mtStore    merkleTreeStore
+	height     uint8
+	// registerLock serializes the registration of the networks at runtime
+	registerLock sync.Mutex
+	proofCache   *proofCache
+}
+
+// Proof is the merkle proof of a deposit. The deposits of the rollups are proved against the local exit root of the
+// rollup, which is proved against the rollup exit root with the rollup merkle proof.
+type Proof struct {
+	MerkleProof [][KeyLen]byte
+	// RollupMerkleProof is empty for the mainnet deposits and when the rollup exit root is the local exit root
+	RollupMerkleProof [][KeyLen]byte
+	RollupIndex       uint
 }
 
 var (
@@ -31,105 +55,560 @@ var (
 func NewBridgeController(cfg Config, networks []uint, bridgeStore interface{}, mtStore interface{}) (*BridgeController, error) {
 	var (
 		networkIDs = make(map[uint]uint8)
-		exitTrees  []*MerkleTree
+		exitTrees  = make(map[uint8]*MerkleTree)
 	)
 
+	bt := &BridgeController{
+		exitTrees:  exitTrees,
+		networkIDs: networkIDs,
+		storage:    bridgeStore.(bridgeStorage),
+		mtStore:    mtStore.(merkleTreeStore),
+		height:     cfg.Height,
+		proofCache: newProofCache(cfg.ProofCache.Size),
+	}
 	for i, network := range networks {
 		networkIDs[network] = uint8(i)
-		mt, err := NewMerkleTree(context.TODO(), mtStore.(merkleTreeStore), cfg.Height, uint8(i))
+		mt, err := NewMerkleTree(context.TODO(), bt.mtStore, cfg.Height, uint8(i))
 		if err != nil {
 			return nil, err
 		}
-		exitTrees = append(exitTrees, mt)
+		err = bt.reconcileTree(context.TODO(), network, mt)
+		if err != nil {
+			return nil, err
+		}
+		exitTrees[uint8(i)] = mt
 	}
+	return bt, nil
+}
 
-	return &BridgeController{
-		exitTrees:  exitTrees,
-		networkIDs: networkIDs,
-		storage:    bridgeStore.(bridgeStorage),
-	}, nil
+// reconcileTree makes the exit tree of the network match the synced deposits. The merkle tree stores which are not
+// part of the db transaction are written after it is committed, so the tree misses the last deposits if the service
+// stopped in between. The missing leaves are added again and the leaves of the deposits which are not stored are
+// removed.
+func (bt *BridgeController) reconcileTree(ctx context.Context, networkID uint, mt *MerkleTree) error {
+	if _, ok := bt.mtStore.(txMerkleTreeStore); !ok {
+		return nil
+	}
+	depositCnt, err := bt.storage.GetNetworkDepositCount(ctx, networkID, nil)
+	if err != nil {
+		return err
+	}
+	if mt.count > depositCnt {
+		log.Warnf("networkID: %d, the exit tree has %d leaves but there are %d deposits, removing the extra leaves", networkID, mt.count, depositCnt)
+		err = mt.resetLeaf(ctx, depositCnt, nil)
+		if err != nil {
+			return fmt.Errorf("networkID: %d, error removing the extra leaves of the exit tree, it must be rebuilt: %w", networkID, err)
+		}
+		return nil
+	}
+	if mt.count < depositCnt {
+		log.Warnf("networkID: %d, the exit tree has %d leaves but there are %d deposits, adding the missing leaves", networkID, mt.count, depositCnt)
+	}
+	for mt.count < depositCnt {
+		deposits, err := bt.storage.GetNetworkDeposits(ctx, networkID, mt.count, checkerPageSize, nil)
+		if err != nil {
+			return err
+		}
+		leaves, missing := depositLeaves(deposits, mt.count)
+		// The roots of the rollups are stored by block, so the verified local exit roots and the reorgs find them
+		err = addBlockLeaves(ctx, mt, networkID, deposits, leaves, nil)
+		if err != nil {
+			return err
+		}
+		if missing || len(leaves) == 0 {
+			return fmt.Errorf("networkID: %d, deposit %d is missing", networkID, mt.count)
+		}
+	}
+	return nil
+}
+
+// AddDeposit adds deposit information to the bridge tree inside the db transaction.
+// The in-memory state of the tree is updated when CommitMT is called after dbTx is committed.
+func (bt *BridgeController) AddDeposit(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error {
+	leaf := HashDeposit(deposit)
+
+	mt, _, err := bt.exitTree(deposit.NetworkID)
+	if err != nil {
+		return err
+	}
+	return mt.addLeaf(ctx, leaf, dbTx)
 }
 
-// AddDeposit adds deposit information to the bridge tree.
-func (bt *BridgeController) AddDeposit(deposit *etherman.Deposit) error {
-	leaf := hashDeposit(deposit)
+// AddDeposits adds a list of deposits to the bridge trees inside the db transaction. The deposits are grouped
+// per network and the leaves of each network are written in one batch. The mainnet exit root is updated in the
+// global exit root by every deposit, so all its intermediate roots are kept. For the rest of networks only the
+// final root is stored.
+// The in-memory state of the trees is updated when CommitMT is called after dbTx is committed.
+func (bt *BridgeController) AddDeposits(ctx context.Context, deposits []*etherman.Deposit, dbTx pgx.Tx) error {
+	var networks []uint
+	exitTrees := make(map[uint]*MerkleTree)
+	leaves := make(map[uint][][KeyLen]byte)
+	for _, deposit := range deposits {
+		if _, found := exitTrees[deposit.NetworkID]; !found {
+			mt, _, err := bt.exitTree(deposit.NetworkID)
+			if err != nil {
+				return err
+			}
+			exitTrees[deposit.NetworkID] = mt
+			networks = append(networks, deposit.NetworkID)
+		}
+		leaves[deposit.NetworkID] = append(leaves[deposit.NetworkID], HashDeposit(deposit))
+	}
 
-	tID, found := bt.networkIDs[deposit.NetworkID]
+	for _, networkID := range networks {
+		err := exitTrees[networkID].addLeaves(ctx, leaves[networkID], networkID == MainNetworkID, dbTx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommitMT applies the changes done inside dbTx to the in-memory state of the specific merkle tree.
+// It must be called once dbTx is committed.
+func (bt *BridgeController) CommitMT(networkID uint, dbTx pgx.Tx) error {
+	mt, _, err := bt.exitTree(networkID)
+	if err != nil {
+		return err
+	}
+	return mt.commit(context.TODO(), dbTx)
+}
+
+// AddVerifiedExitRoot stores inside dbTx the rollup exit tree with the local exit root verified in the block, the rest
+// of the leaves are the latest verified local exit roots of the other rollups. The batches verified on L1 are the ones
+// of the rollup of the proof of efficiency contract, the first rollup of the config.
+func (bt *BridgeController) AddVerifiedExitRoot(ctx context.Context, localExitRoot common.Hash, blockID uint64, dbTx pgx.Tx) error {
+	networkID, found := bt.treeNetwork(verifiedRollupTreeIndex)
 	if !found {
-		return gerror.ErrNetworkNotRegister
+		return nil
 	}
-	return bt.exitTrees[tID].addLeaf(context.TODO(), leaf)
+	_, err := bt.addRollupExitLeaf(ctx, networkID, verifiedRollupTreeIndex, localExitRoot, blockID, dbTx)
+	return err
+}
+
+// addRollupExitLeaf stores inside dbTx the rollup exit tree with the new local exit root of the rollup and the latest
+// local exit roots of the other rollups, and returns its root. The leaves are removed with the block if it is reorged.
+func (bt *BridgeController) addRollupExitLeaf(ctx context.Context, networkID uint, tID uint8, localExitRoot common.Hash, blockID uint64, dbTx pgx.Tx) (common.Hash, error) {
+	latest, err := bt.storage.GetLatestRollupExitLeaves(ctx, dbTx)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return common.Hash{}, err
+	}
+	leaves := []*etherman.RollupExitLeaf{{NetworkID: networkID, RollupIndex: rollupIndex(tID), LocalExitRoot: localExitRoot}}
+	for _, leaf := range latest {
+		if leaf.NetworkID != networkID {
+			leaves = append(leaves, leaf)
+		}
+	}
+	root, _ := rollupExitTree(leaves, 0, bt.height)
+	return root, bt.storage.AddRollupExitLeaves(ctx, root, leaves, blockID, dbTx)
+}
+
+// localExitRoot returns the exit root of the network included in the global exit root. For the rollups, the leaves
+// of the rollup exit tree are returned too. The rollup exit root synced without leaves is the local exit root of the
+// verified rollup, as in the first version of the contracts, and no leaves are returned.
+func (bt *BridgeController) localExitRoot(ctx context.Context, networkID uint, tID uint8, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) ([KeyLen]byte, []*etherman.RollupExitLeaf, error) {
+	if networkID == MainNetworkID {
+		return globalExitRoot.ExitRoots[0], nil, nil
+	}
+	rollupExitRoot := globalExitRoot.ExitRoots[1]
+	leaves, err := bt.storage.GetRollupExitLeaves(ctx, rollupExitRoot, dbTx)
+	if err == gerror.ErrStorageNotFound && tID == verifiedRollupTreeIndex {
+		return rollupExitRoot, nil, nil
+	}
+	if err != nil {
+		return [KeyLen]byte{}, nil, err
+	}
+	leaf := rollupLeaf(leaves, networkID)
+	if leaf == nil {
+		return [KeyLen]byte{}, nil, gerror.ErrStorageNotFound
+	}
+	return leaf.LocalExitRoot, leaves, nil
+}
+
+// unverifiedRollupError returns ErrExitRootNotVerified if the local exit roots of the rollup are never verified,
+// reading inside dbTx. Only the batches of the verified rollup are verified on L1, so the rest of rollups, like the
+// networks registered at runtime, are missing from the rollup exit roots and their deposits can't be proven.
+func (bt *BridgeController) unverifiedRollupError(ctx context.Context, networkID uint, tID uint8, dbTx pgx.Tx) error {
+	if networkID == MainNetworkID || tID == verifiedRollupTreeIndex {
+		return nil
+	}
+	leaves, err := bt.storage.GetLatestRollupExitLeaves(ctx, dbTx)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return err
+	}
+	if rollupLeaf(leaves, networkID) != nil {
+		return nil
+	}
+	return gerror.ErrExitRootNotVerified
+}
+
+// getSiblings returns the siblings of the deposit against the local exit root, which has depositCnt leaves, from the
+// proof cache or reading the nodes. The caller must hold the read lock of the tree.
+func (bt *BridgeController) getSiblings(ctx context.Context, mt *MerkleTree, nodes nodeReader, networkID uint, index uint, localExitRoot [KeyLen]byte, depositCnt uint, dbTx pgx.Tx) ([][KeyLen]byte, error) {
+	key := proofCacheKey{networkID: networkID, index: index, root: localExitRoot}
+	if siblings, found := bt.proofCache.get(key); found {
+		return siblings, nil
+	}
+	siblings, err := mt.getSiblingsFrom(ctx, nodes, index, localExitRoot, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	bt.proofCache.add(key, depositCnt, siblings)
+	return siblings, nil
+}
+
+// getProof returns the merkle proof of the deposit against the local exit root, and against the rollup exit root
+// if the leaves of the rollup exit tree are provided. The caller must hold the read lock of the tree.
+func (bt *BridgeController) getProof(ctx context.Context, mt *MerkleTree, networkID uint, index uint, localExitRoot [KeyLen]byte, depositCnt uint, leaves []*etherman.RollupExitLeaf, dbTx pgx.Tx) (*Proof, error) {
+	siblings, err := bt.getSiblings(ctx, mt, mt.store, networkID, index, localExitRoot, depositCnt, dbTx)
+	if err != nil {
+		return nil, fmt.Errorf("getting the proof failed, errror: %v, index: %d, root: %v", err, index, localExitRoot)
+	}
+	proof := &Proof{
+		MerkleProof: siblings,
+	}
+	if leaves != nil {
+		proof.RollupIndex = rollupLeaf(leaves, networkID).RollupIndex
+		_, proof.RollupMerkleProof = rollupExitTree(leaves, proof.RollupIndex, bt.height)
+	}
+	return proof, nil
+}
+
+// ExitRootDepositCounts returns the number of deposits of every registered network which are included in the global
+// exit root, reading inside dbTx. The networks whose local exit root isn't known are missing.
+func (bt *BridgeController) ExitRootDepositCounts(ctx context.Context, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) (map[uint]uint, error) {
+	depositCnts := make(map[uint]uint)
+	for _, networkID := range bt.networks() {
+		depositCnt, err := bt.includedDepositCount(ctx, networkID, globalExitRoot, dbTx)
+		if err != nil {
+			if err != gerror.ErrStorageNotFound {
+				return nil, err
+			}
+			continue
+		}
+		depositCnts[networkID] = depositCnt
+	}
+	return depositCnts, nil
+}
+
+// includedDepositCount returns the number of deposits of the network which are included in the global exit root,
+// reading inside dbTx.
+func (bt *BridgeController) includedDepositCount(ctx context.Context, networkID uint, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) (uint, error) {
+	mt, tID, err := bt.exitTree(networkID)
+	if err != nil {
+		return 0, err
+	}
+	localExitRoot, _, err := bt.localExitRoot(ctx, networkID, tID, globalExitRoot, dbTx)
+	if err != nil {
+		return 0, err
+	}
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+	return mt.store.GetDepositCountByRoot(ctx, localExitRoot[:], tID, dbTx)
+}
+
+// readSnapshot runs fn inside a read only repeatable read transaction, so all its reads see the same committed state
+// even if the synchronizer commits or reorgs the trees meanwhile.
+func (bt *BridgeController) readSnapshot(ctx context.Context, fn func(dbTx pgx.Tx) error) error {
+	dbTx, err := bt.storage.BeginSnapshotDBTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	defer bt.storage.Rollback(ctx, dbTx) //nolint:errcheck
+	return fn(dbTx)
 }
 
 // GetClaim returns claim information to the user.
-func (bt *BridgeController) GetClaim(networkID uint, index uint) ([][KeyLen]byte, *etherman.GlobalExitRoot, error) {
+func (bt *BridgeController) GetClaim(networkID uint, index uint) (*Proof, *etherman.GlobalExitRoot, error) {
+	var (
+		proof          *Proof
+		globalExitRoot *etherman.GlobalExitRoot
+	)
+	ctx := context.TODO()
+	err := bt.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		proof, globalExitRoot, err = bt.getClaim(ctx, networkID, index, dbTx)
+		return err
+	})
+	return proof, globalExitRoot, err
+}
+
+// getClaim returns the merkle proof of the deposit against the latest global exit root read inside dbTx.
+func (bt *BridgeController) getClaim(ctx context.Context, networkID uint, index uint, dbTx pgx.Tx) (*Proof, *etherman.GlobalExitRoot, error) {
 	var (
-		proof          [][KeyLen]byte
 		globalExitRoot *etherman.GlobalExitRoot
 		err            error
 	)
 
-	tID, found := bt.networkIDs[networkID]
-	if !found {
-		return proof, nil, gerror.ErrNetworkNotRegister
+	mt, tID, err := bt.exitTree(networkID)
+	if err != nil {
+		return nil, nil, err
 	}
-	ctx := context.TODO()
 	if networkID == MainNetworkID {
-		globalExitRoot, err = bt.storage.GetLatestTrustedExitRoot(ctx, nil)
+		globalExitRoot, err = bt.storage.GetLatestTrustedExitRoot(ctx, dbTx)
 	} else {
-		globalExitRoot, err = bt.storage.GetLatestL1SyncedExitRoot(ctx, nil)
+		globalExitRoot, err = bt.storage.GetLatestL1SyncedExitRoot(ctx, dbTx)
 	}
 	if err != nil {
-		return proof, nil, fmt.Errorf("getting the last GER failed, error: %v", err)
+		return nil, nil, fmt.Errorf("getting the last GER failed, error: %v", err)
 	}
-	depositCnt, err := bt.storage.GetDepositCountByRoot(ctx, globalExitRoot.ExitRoots[tID][:], tID, nil)
+	localExitRoot, leaves, err := bt.localExitRoot(ctx, networkID, tID, globalExitRoot, dbTx)
 	if err != nil {
-		return proof, nil, fmt.Errorf("getting deposit count from the MT root failed, error: %v, root: %v, network: %d", err, globalExitRoot.ExitRoots[tID][:], tID)
+		if err != gerror.ErrStorageNotFound {
+			return nil, nil, fmt.Errorf("getting the local exit root failed, error: %v", err)
+		}
+		if err = bt.unverifiedRollupError(ctx, networkID, tID, dbTx); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, gerror.ErrDepositNotSynced
+	}
+
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+	depositCnt, err := mt.store.GetDepositCountByRoot(ctx, localExitRoot[:], tID, dbTx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting deposit count from the MT root failed, error: %v, root: %v, network: %d", err, localExitRoot, tID)
 	}
 	if depositCnt < index {
-		return proof, nil, gerror.ErrDepositNotSynced
+		return nil, nil, gerror.ErrDepositNotSynced
 	}
 
+	proof, err := bt.getProof(ctx, mt, networkID, index, localExitRoot, depositCnt, leaves, dbTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proof, globalExitRoot, nil
+}
+
+// GetClaimByExitRoot returns the merkle proof of the deposit against a specific global exit root.
+// The exit root of the deposit network must include the deposit.
+func (bt *BridgeController) GetClaimByExitRoot(networkID uint, index uint, globalExitRoot *etherman.GlobalExitRoot) (*Proof, error) {
+	var proof *Proof
+	ctx := context.TODO()
+	err := bt.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		proof, err = bt.getClaimByExitRoot(ctx, networkID, index, globalExitRoot, dbTx)
+		return err
+	})
+	return proof, err
+}
+
+// getClaimByExitRoot returns the merkle proof of the deposit against the global exit root reading inside dbTx.
+func (bt *BridgeController) getClaimByExitRoot(ctx context.Context, networkID uint, index uint, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) (*Proof, error) {
+	mt, tID, err := bt.exitTree(networkID)
+	if err != nil {
+		return nil, err
+	}
+	localExitRoot, leaves, err := bt.localExitRoot(ctx, networkID, tID, globalExitRoot, dbTx)
 	if err != nil {
 		if err != gerror.ErrStorageNotFound {
-			return proof, nil, fmt.Errorf("getting the GER failed, error: %v", err)
+			return nil, fmt.Errorf("getting the local exit root failed, error: %v", err)
 		}
-		return proof, nil, gerror.ErrDepositNotSynced
+		if err = bt.unverifiedRollupError(ctx, networkID, tID, dbTx); err != nil {
+			return nil, err
+		}
+		return nil, gerror.ErrDepositNotIncluded
 	}
 
-	proof, err = bt.exitTrees[tID].getSiblings(ctx, index, globalExitRoot.ExitRoots[tID])
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+	depositCnt, err := mt.store.GetDepositCountByRoot(ctx, localExitRoot[:], tID, dbTx)
 	if err != nil {
-		return proof, nil, fmt.Errorf("getting the proof failed, errror: %v, index: %d, root: %v", err, index, globalExitRoot.ExitRoots[tID])
+		if err != gerror.ErrStorageNotFound {
+			return nil, fmt.Errorf("getting deposit count from the MT root failed, error: %v, root: %v, network: %d", err, localExitRoot, tID)
+		}
+		return nil, gerror.ErrDepositNotIncluded
+	}
+	if depositCnt <= index {
+		return nil, gerror.ErrDepositNotIncluded
 	}
+	return bt.getProof(ctx, mt, networkID, index, localExitRoot, depositCnt, leaves, dbTx)
+}
 
-	return proof, globalExitRoot, err
+// ReorgMT reorg the specific merkle tree inside the db transaction.
+// The in-memory state of the tree is updated when CommitMT is called after dbTx is committed.
+// The cached proofs against the removed roots are discarded, even if dbTx is rolled back later.
+func (bt *BridgeController) ReorgMT(ctx context.Context, depositCount uint, networkID uint, dbTx pgx.Tx) error {
+	mt, _, err := bt.exitTree(networkID)
+	if err != nil {
+		return err
+	}
+	err = mt.resetLeaf(ctx, depositCount, dbTx)
+	if err != nil {
+		return err
+	}
+	bt.proofCache.reorg(networkID, depositCount)
+	return nil
+}
+
+// ProofCacheStats returns the stats of the proof cache.
+func (bt *BridgeController) ProofCacheStats() ProofCacheStats {
+	return bt.proofCache.getStats()
 }
 
-// ReorgMT reorg the specific merkle tree.
-func (bt *BridgeController) ReorgMT(depositCount uint, networkID uint) error {
+// exitTree returns the exit tree of the network and its index in the merkle tree store.
+func (bt *BridgeController) exitTree(networkID uint) (*MerkleTree, uint8, error) {
+	bt.lock.RLock()
+	defer bt.lock.RUnlock()
 	tID, found := bt.networkIDs[networkID]
 	if !found {
+		return nil, 0, gerror.ErrNetworkNotRegister
+	}
+	return bt.exitTrees[tID], tID, nil
+}
+
+// ClaimSourceNetwork returns the network of the deposit of the claim, reading inside dbTx. The claims of the rollups
+// are proven against the mainnet exit root. The claims of mainnet are linked to the rollup whose local exit root is the
+// rollup exit root of the claim, or else to the rollup deposit with the same leaf. The claims which can't be linked are
+// assumed to be of the first rollup, the only one of the first version of the contracts.
+func (bt *BridgeController) ClaimSourceNetwork(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (uint, error) {
+	if claim.NetworkID != MainNetworkID {
+		return MainNetworkID, nil
+	}
+	if claim.RollupExitRoot != (common.Hash{}) {
+		bt.lock.RLock()
+		tIDs := make(map[uint]uint8, len(bt.networkIDs))
+		for networkID, tID := range bt.networkIDs {
+			if networkID != MainNetworkID {
+				tIDs[networkID] = tID
+			}
+		}
+		bt.lock.RUnlock()
+		for networkID, tID := range tIDs {
+			_, err := bt.mtStore.GetDepositCountByRoot(ctx, claim.RollupExitRoot[:], tID, dbTx)
+			if err == nil {
+				return networkID, nil
+			}
+			if err != gerror.ErrStorageNotFound {
+				return 0, err
+			}
+		}
+	}
+	networkID, err := bt.storage.GetClaimedDepositNetwork(ctx, claim, dbTx)
+	if err == nil {
+		return networkID, nil
+	}
+	if err != gerror.ErrStorageNotFound {
+		return 0, err
+	}
+	log.Warnf("the deposit of the claim %d in the tx %s is unknown, it is linked to the network %d", claim.Index, claim.TxHash, defaultRollupNetworkID)
+	return defaultRollupNetworkID, nil
+}
+
+// networks returns the ids of the registered networks.
+func (bt *BridgeController) networks() []uint {
+	bt.lock.RLock()
+	defer bt.lock.RUnlock()
+	networks := make([]uint, 0, len(bt.networkIDs))
+	for networkID := range bt.networkIDs {
+		networks = append(networks, networkID)
+	}
+	return networks
+}
+
+// treeNetwork returns the registered network whose exit tree has the index tID.
+func (bt *BridgeController) treeNetwork(tID uint8) (uint, bool) {
+	bt.lock.RLock()
+	defer bt.lock.RUnlock()
+	for networkID, networkTID := range bt.networkIDs {
+		if networkTID == tID {
+			return networkID, true
+		}
+	}
+	return 0, false
+}
+
+// RegisterNetwork adds the exit tree of a network registered at runtime, whose tree is kept in the merkle tree
+// store with the index tID, which is also the position of its leaf in the rollup exit tree. Its local exit roots
+// aren't verified on L1, so its deposits are synced but their proofs fail with ErrExitRootNotVerified.
+func (bt *BridgeController) RegisterNetwork(ctx context.Context, networkID uint, tID uint8) error {
+	if networkID == MainNetworkID {
+		return gerror.ErrNetworkAlreadyRegistered
+	}
+	bt.registerLock.Lock()
+	defer bt.registerLock.Unlock()
+
+	bt.lock.RLock()
+	_, found := bt.networkIDs[networkID]
+	_, used := bt.exitTrees[tID]
+	bt.lock.RUnlock()
+	if found || used {
+		return gerror.ErrNetworkAlreadyRegistered
+	}
+	// The registered networks only change holding registerLock, so the tree is loaded without blocking the proofs
+	mt, err := NewMerkleTree(ctx, bt.mtStore, bt.height, tID)
+	if err != nil {
+		return err
+	}
+	err = bt.reconcileTree(ctx, networkID, mt)
+	if err != nil {
+		return err
+	}
+	bt.lock.Lock()
+	bt.networkIDs[networkID] = tID
+	bt.exitTrees[tID] = mt
+	bt.lock.Unlock()
+	return nil
+}
+
+// DeregisterNetwork removes the exit tree of a network, the tree stays in the merkle tree store. The synchronizer of
+// the network must be stopped before. The mainnet can't be deregistered.
+func (bt *BridgeController) DeregisterNetwork(ctx context.Context, networkID uint) error {
+	if networkID == MainNetworkID {
+		return gerror.ErrNetworkNotRegister
+	}
+	bt.registerLock.Lock()
+	defer bt.registerLock.Unlock()
+
+	bt.lock.Lock()
+	tID, found := bt.networkIDs[networkID]
+	if !found {
+		bt.lock.Unlock()
 		return gerror.ErrNetworkNotRegister
 	}
-	return bt.exitTrees[tID].resetLeaf(context.TODO(), depositCount)
+	delete(bt.networkIDs, networkID)
+	delete(bt.exitTrees, tID)
+	bt.lock.Unlock()
+	bt.proofCache.clear(networkID)
+	return nil
 }
 
-// MockAddDeposit adds deposit information to the bridge tree with globalExitRoot.
+// MockAddDeposit adds deposit information to the bridge tree with globalExitRoot. The local exit root of a rollup
+// is verified with the deposit.
 func (bt *BridgeController) MockAddDeposit(deposit *etherman.Deposit) error {
-	err := bt.AddDeposit(deposit)
+	ctx := context.TODO()
+	err := bt.AddDeposit(ctx, deposit, nil)
 	if err != nil {
 		return err
 	}
-	return bt.storage.AddGlobalExitRoot(context.TODO(), &etherman.GlobalExitRoot{
+	var rollupExitRoot common.Hash
+	if deposit.NetworkID == MainNetworkID {
+		leaves, err := bt.storage.GetLatestRollupExitLeaves(ctx, nil)
+		if err != nil && err != gerror.ErrStorageNotFound {
+			return err
+		}
+		rollupExitRoot, _ = rollupExitTree(leaves, 0, bt.height)
+	} else {
+		mt, tID, err := bt.exitTree(deposit.NetworkID)
+		if err != nil {
+			return err
+		}
+		rollupExitRoot, err = bt.addRollupExitLeaf(ctx, deposit.NetworkID, tID, mt.committedRoot(), deposit.BlockID, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return bt.storage.AddGlobalExitRoot(ctx, &etherman.GlobalExitRoot{
 		BlockNumber: 0,
-		ExitRoots:   []common.Hash{common.BytesToHash(bt.exitTrees[0].root[:]), common.BytesToHash(bt.exitTrees[1].root[:])},
+		ExitRoots:   []common.Hash{common.BytesToHash(bt.exitTrees[0].root[:]), rollupExitRoot},
 		BlockID:     deposit.BlockID,
 	}, nil)
 }
 
-// GetTokenWrapped returns tokenWrapped information.
-func (bt *BridgeController) GetTokenWrapped(origNetwork uint, origTokenAddr common.Address) (*etherman.TokenWrapped, error) {
-	tokenWrapped, err := bt.storage.GetTokenWrapped(context.Background(), origNetwork, origTokenAddr, nil)
+// GetTokenWrapped returns tokenWrapped information of the original token in the network, or in any network if it's
+// nil.
+func (bt *BridgeController) GetTokenWrapped(origNetwork uint, origTokenAddr common.Address, networkID *uint) (*etherman.TokenWrapped, error) {
+	tokenWrapped, err := bt.storage.GetTokenWrapped(context.Background(), origNetwork, origTokenAddr, networkID, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -10,9 +10,10 @@ import (
 	"runtime"
 	"testing"
 
-	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/test/vectors"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -37,29 +38,18 @@ func TestBridgeTree(t *testing.T) {
 	err = json.Unmarshal(data, &testVectors)
 	require.NoError(t, err)
 
-	dbCfg := pgstorage.NewConfigFromEnv()
-	err = pgstorage.InitOrReset(dbCfg)
-	require.NoError(t, err)
-
 	cfg := Config{
 		Height: uint8(32), //nolint:gomnd
-		Store:  "postgres",
 	}
 
-	store, err := pgstorage.NewPostgresStorage(dbCfg)
-	require.NoError(t, err)
+	store := newMemoryStorage()
+	id := uint64(1)
 
-	id, err := store.AddBlock(context.TODO(), &etherman.Block{
-		BlockNumber: 0,
-		BlockHash:   common.HexToHash("0x29e885edaf8e4b51e1d2e05f9da28161d2fb4f6b1d53827d9b80a23cf2d7d9fc"),
-		ParentHash:  common.Hash{},
-	}, nil)
-	require.NoError(t, err)
-
-	bt, err := NewBridgeController(cfg, []uint{0, 1000}, store, store)
+	bt, err := NewBridgeController(cfg, []uint{0, 1000}, store, kvstorage.NewMemoryStorage())
 	require.NoError(t, err)
 
 	ctx := context.TODO()
+	var firstExitRoot *etherman.GlobalExitRoot
 	t.Run("Test adding deposit for the bridge tree", func(t *testing.T) {
 		for i, testVector := range testVectors {
 			amount, _ := new(big.Int).SetString(testVector.Amount, 0)
@@ -74,18 +64,18 @@ func TestBridgeTree(t *testing.T) {
 				DepositCount:       uint(i),
 				Metadata:           common.FromHex(testVector.Metadata),
 			}
-			leafHash := hashDeposit(deposit)
+			leafHash := HashDeposit(deposit)
 			assert.Equal(t, testVector.ExpectedHash, hex.EncodeToString(leafHash[:]))
 
-			err = bt.AddDeposit(deposit)
+			err = bt.AddDeposit(ctx, deposit, nil)
 			require.NoError(t, err)
 
 			// test reorg
 			orgRoot, err := bt.exitTrees[0].store.GetRoot(ctx, uint(i+1), 0, nil)
 			require.NoError(t, err)
-			err = bt.ReorgMT(uint(i), testVectors[i].OriginalNetwork)
+			err = bt.ReorgMT(ctx, uint(i), testVectors[i].OriginalNetwork, nil)
 			require.NoError(t, err)
-			err = bt.AddDeposit(deposit)
+			err = bt.AddDeposit(ctx, deposit, nil)
 			require.NoError(t, err)
 			newRoot, err := bt.exitTrees[0].store.GetRoot(ctx, uint(i+1), 0, nil)
 			require.NoError(t, err)
@@ -98,6 +88,12 @@ func TestBridgeTree(t *testing.T) {
 				BlockID:        id,
 			}, nil)
 			require.NoError(t, err)
+			if i == 0 {
+				firstExitRoot = &etherman.GlobalExitRoot{
+					GlobalExitRoot: hash(common.BytesToHash(bt.exitTrees[0].root[:]), common.BytesToHash(bt.exitTrees[1].root[:])),
+					ExitRoots:      []common.Hash{common.BytesToHash(bt.exitTrees[0].root[:]), common.BytesToHash(bt.exitTrees[1].root[:])},
+				}
+			}
 
 			err = store.AddTrustedGlobalExitRoot(context.TODO(), &etherman.GlobalExitRoot{
 				BlockNumber:    0,
@@ -111,7 +107,220 @@ func TestBridgeTree(t *testing.T) {
 		for i, testVector := range testVectors {
 			proof, _, err := bt.GetClaim(testVector.OriginalNetwork, uint(i))
 			require.NoError(t, err)
-			require.Equal(t, len(proof), 32)
+			require.Equal(t, len(proof.MerkleProof), 32)
+		}
+	})
+
+	t.Run("Test getting the proof against a previous exit root", func(t *testing.T) {
+		proof, err := bt.GetClaimByExitRoot(testVectors[0].OriginalNetwork, 0, firstExitRoot)
+		require.NoError(t, err)
+		require.Equal(t, len(proof.MerkleProof), 32)
+		require.Empty(t, proof.RollupMerkleProof)
+
+		_, err = bt.GetClaimByExitRoot(testVectors[1].OriginalNetwork, 1, firstExitRoot)
+		require.ErrorIs(t, err, gerror.ErrDepositNotIncluded)
+	})
+
+	t.Run("Test getting the proof of a rollup deposit against the rollup exit root", func(t *testing.T) {
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x1"),
+			Amount:             big.NewInt(1),
+			DestinationNetwork: 0,
+			DestinationAddress: common.HexToAddress("0x2"),
+			BlockID:            id,
+			DepositCount:       0,
+			NetworkID:          1000,
+		}
+		require.NoError(t, bt.MockAddDeposit(deposit))
+
+		proof, exitRoot, err := bt.GetClaim(1000, 0)
+		require.NoError(t, err)
+		// The first rollup is the leaf 0 of the rollup exit tree
+		require.Equal(t, uint(0), proof.RollupIndex)
+		require.Equal(t, zeroHashes[:32], proof.RollupMerkleProof)
+		localExitRoot := CalculateRoot(HashDeposit(deposit), 0, proof.MerkleProof)
+		require.Equal(t, bt.exitTrees[1].root, localExitRoot)
+		require.Equal(t, CalculateRoot(localExitRoot, 0, zeroHashes[:32]), [KeyLen]byte(exitRoot.ExitRoots[1]))
+	})
+
+	t.Run("Test getting the proofs of several deposits", func(t *testing.T) {
+		ctx := context.Background()
+		results, err := bt.GetProofs(ctx, []DepositKey{
+			{NetworkID: 0, DepositCount: 0},
+			{NetworkID: 1000, DepositCount: 0},
+			{NetworkID: 0, DepositCount: 1},
+			{NetworkID: 1000, DepositCount: 100},
+			{NetworkID: 5, DepositCount: 0},
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 5)
+		for i, deposit := range []DepositKey{{0, 0}, {1000, 0}, {0, 1}} {
+			require.NoError(t, results[i].Err)
+			proof, exitRoot, err := bt.GetClaim(deposit.NetworkID, deposit.DepositCount)
+			require.NoError(t, err)
+			assert.Equal(t, proof, results[i].Proof)
+			assert.Equal(t, exitRoot.ExitRoots, results[i].GlobalExitRoot.ExitRoots)
+		}
+		require.ErrorIs(t, results[3].Err, gerror.ErrDepositNotSynced)
+		require.ErrorIs(t, results[4].Err, gerror.ErrNetworkNotRegister)
+
+		results, err = bt.GetProofs(ctx, []DepositKey{{NetworkID: 0, DepositCount: 0}, {NetworkID: 0, DepositCount: 1}}, &firstExitRoot.GlobalExitRoot)
+		require.NoError(t, err)
+		require.NoError(t, results[0].Err)
+		require.ErrorIs(t, results[1].Err, gerror.ErrDepositNotIncluded)
+	})
+
+	t.Run("Test registering a network at runtime", func(t *testing.T) {
+		ctx := context.Background()
+		require.ErrorIs(t, bt.RegisterNetwork(ctx, 1000, 2), gerror.ErrNetworkAlreadyRegistered)
+		require.ErrorIs(t, bt.RegisterNetwork(ctx, 1001, 1), gerror.ErrNetworkAlreadyRegistered)
+
+		require.NoError(t, bt.RegisterNetwork(ctx, 1001, 2))
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x1"),
+			Amount:             big.NewInt(1),
+			DestinationNetwork: 0,
+			DestinationAddress: common.HexToAddress("0x2"),
+			BlockID:            id,
+			DepositCount:       0,
+			NetworkID:          1001,
+		}
+		require.NoError(t, store.AddDeposit(ctx, deposit, nil))
+		require.NoError(t, bt.MockAddDeposit(deposit))
+		proof, exitRoot, err := bt.GetClaim(1001, 0)
+		require.NoError(t, err)
+		// The rollup index comes from the exit tree of the network, not from the network id
+		require.Equal(t, uint(1), proof.RollupIndex)
+		localExitRoot := CalculateRoot(HashDeposit(deposit), 0, proof.MerkleProof)
+		rollupExitRoot := CalculateRoot(hash(bt.exitTrees[1].root, localExitRoot), 0, zeroHashes[1:32])
+		require.Equal(t, rollupExitRoot, [KeyLen]byte(exitRoot.ExitRoots[1]))
+		require.Equal(t, rollupExitRoot, CalculateRoot(localExitRoot, proof.RollupIndex, proof.RollupMerkleProof))
+
+		require.NoError(t, bt.DeregisterNetwork(ctx, 1001))
+		_, _, err = bt.GetClaim(1001, 0)
+		require.ErrorIs(t, err, gerror.ErrNetworkNotRegister)
+		require.ErrorIs(t, bt.DeregisterNetwork(ctx, 1001), gerror.ErrNetworkNotRegister)
+
+		// The exit tree is loaded again from the store
+		require.NoError(t, bt.RegisterNetwork(ctx, 1001, 2))
+		require.Equal(t, localExitRoot, bt.exitTrees[2].root)
+	})
+
+	t.Run("Test adding a verified local exit root", func(t *testing.T) {
+		ctx := context.Background()
+		verifiedRoot := common.HexToHash("0x5ca1e")
+		require.NoError(t, bt.AddVerifiedExitRoot(ctx, verifiedRoot, id, nil))
+		leaves, err := store.GetLatestRollupExitLeaves(ctx, nil)
+		require.NoError(t, err)
+		require.Len(t, leaves, 2)
+		// Only the leaf of the verified rollup changes
+		assert.Equal(t, verifiedRoot, rollupLeaf(leaves, 1000).LocalExitRoot)
+		assert.Equal(t, common.Hash(bt.exitTrees[2].root), rollupLeaf(leaves, 1001).LocalExitRoot)
+		root, _ := rollupExitTree(leaves, 0, 32)
+		assert.Equal(t, CalculateRoot(hash(verifiedRoot, bt.exitTrees[2].root), 0, zeroHashes[1:32]), root)
+	})
+
+	t.Run("Test the proofs of a network whose exit roots aren't verified", func(t *testing.T) {
+		ctx := context.Background()
+		require.NoError(t, bt.RegisterNetwork(ctx, 1002, 3))
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x1"),
+			Amount:             big.NewInt(1),
+			DestinationNetwork: 0,
+			DestinationAddress: common.HexToAddress("0x2"),
+			BlockID:            id,
+			DepositCount:       0,
+			NetworkID:          1002,
 		}
+		require.NoError(t, store.AddDeposit(ctx, deposit, nil))
+		require.NoError(t, bt.AddDeposit(ctx, deposit, nil))
+		require.NoError(t, bt.CommitMT(1002, nil))
+
+		_, _, err := bt.GetClaim(1002, 0)
+		require.ErrorIs(t, err, gerror.ErrExitRootNotVerified)
+		exitRoot, err := store.GetLatestL1SyncedExitRoot(ctx, nil)
+		require.NoError(t, err)
+		_, err = bt.GetClaimByExitRoot(1002, 0, exitRoot)
+		require.ErrorIs(t, err, gerror.ErrExitRootNotVerified)
+		results, err := bt.GetProofs(ctx, []DepositKey{{NetworkID: 1002, DepositCount: 0}, {NetworkID: 1001, DepositCount: 0}}, nil)
+		require.NoError(t, err)
+		require.ErrorIs(t, results[0].Err, gerror.ErrExitRootNotVerified)
+		require.NoError(t, results[1].Err)
 	})
 }
+
+func TestReconcileTree(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{
+		Height: uint8(32), //nolint:gomnd
+	}
+	var deposits []*etherman.Deposit
+	for i := 0; i < 4; i++ {
+		deposits = append(deposits, &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x1"),
+			Amount:             big.NewInt(int64(i + 1)),
+			DestinationNetwork: 1,
+			DestinationAddress: common.HexToAddress("0x2"),
+			BlockID:            1,
+			DepositCount:       uint(i),
+		})
+	}
+	newStorage := func(deposits ...*etherman.Deposit) *memoryStorage {
+		store := newMemoryStorage()
+		for _, deposit := range deposits {
+			require.NoError(t, store.AddDeposit(ctx, deposit, nil))
+		}
+		return store
+	}
+	mtStore := kvstorage.NewMemoryStorage()
+	bt, err := NewBridgeController(cfg, []uint{0}, newStorage(), mtStore)
+	require.NoError(t, err)
+	require.NoError(t, bt.AddDeposits(ctx, deposits[:2], nil))
+	root := bt.exitTrees[0].root
+
+	// The deposits synced while the tree store wasn't written are added to the tree
+	bt, err = NewBridgeController(cfg, []uint{0}, newStorage(deposits...), mtStore)
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), bt.exitTrees[0].count)
+	expected, err := NewMerkleTree(ctx, kvstorage.NewMemoryStorage(), cfg.Height, 0)
+	require.NoError(t, err)
+	require.NoError(t, expected.addLeaves(ctx, [][KeyLen]byte{HashDeposit(deposits[0]), HashDeposit(deposits[1]), HashDeposit(deposits[2]), HashDeposit(deposits[3])}, true, nil))
+	assert.Equal(t, expected.root, bt.exitTrees[0].root)
+
+	// The leaves of the deposits which are not stored are removed
+	bt, err = NewBridgeController(cfg, []uint{0}, newStorage(deposits[:2]...), mtStore)
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), bt.exitTrees[0].count)
+	assert.Equal(t, root, bt.exitTrees[0].root)
+
+	// The tree can't be completed if a deposit is missing
+	_, err = NewBridgeController(cfg, []uint{0}, newStorage(deposits[0], deposits[1], deposits[3]), mtStore)
+	require.Error(t, err)
+
+	// The roots of a rollup are stored at the end of every block, as the synchronizer does
+	var rollupDeposits []*etherman.Deposit
+	for i, blockID := range []uint64{1, 1, 2, 2, 3} {
+		rollupDeposits = append(rollupDeposits, &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x1"),
+			Amount:             big.NewInt(int64(i + 1)),
+			DestinationAddress: common.HexToAddress("0x2"),
+			BlockID:            blockID,
+			DepositCount:       uint(i),
+			NetworkID:          1,
+		})
+	}
+	bt, err = NewBridgeController(cfg, []uint{0, 1}, newStorage(rollupDeposits...), kvstorage.NewMemoryStorage())
+	require.NoError(t, err)
+	mt := bt.exitTrees[1]
+	for depositCnt, stored := range []bool{true, false, true, false, true, true} {
+		_, err = mt.store.GetRoot(ctx, uint(depositCnt), 1, nil)
+		if stored {
+			assert.NoError(t, err, "depositCnt %d", depositCnt)
+		} else {
+			assert.ErrorIs(t, err, gerror.ErrStorageNotFound, "depositCnt %d", depositCnt)
+		}
+	}
+	// A reorg to the end of a block finds its root
+	require.NoError(t, bt.ReorgMT(ctx, 2, 1, nil))
+	assert.Equal(t, uint(2), mt.count)
+}
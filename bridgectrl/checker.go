@@ -0,0 +1,312 @@
+package bridgectrl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+const checkerPageSize = 1000
+
+// TreeDivergence is the first difference found between the stored exit tree and the tree rebuilt from the deposits.
+type TreeDivergence struct {
+	DepositCount uint
+	StoredRoot   common.Hash
+	ExpectedRoot common.Hash
+	Reason       string
+}
+
+// TreeReport is the result of checking the exit tree of a network.
+type TreeReport struct {
+	NetworkID uint
+	// DepositCount is the number of deposits used to rebuild the tree
+	DepositCount uint
+	// Root is the root of the rebuilt tree
+	Root common.Hash
+	// Divergence is nil if the stored tree matches the rebuilt tree
+	Divergence *TreeDivergence
+}
+
+// TreeChecker rebuilds the exit trees from the stored deposits to verify or rewrite the stored trees.
+type TreeChecker struct {
+	storage checkerStorage
+	store   merkleTreeStore
+	height  uint8
+}
+
+// NewTreeChecker creates new TreeChecker.
+func NewTreeChecker(cfg Config, storage interface{}, mtStore interface{}) *TreeChecker {
+	return &TreeChecker{
+		storage: storage.(checkerStorage),
+		store:   mtStore.(merkleTreeStore),
+		height:  cfg.Height,
+	}
+}
+
+// emptyTree returns a tree without leaves for the network.
+func (tc *TreeChecker) emptyTree(tID uint8) *MerkleTree {
+	mt := &MerkleTree{
+		store:    tc.store,
+		network:  tID,
+		height:   tc.height,
+		root:     zeroHashes[tc.height],
+		frontier: make([][KeyLen]byte, tc.height),
+	}
+	copy(mt.frontier, zeroHashes[:tc.height])
+	return mt
+}
+
+// Verify rebuilds in memory the exit tree of the network from its deposits ordered by deposit count. Every root
+// stored in the tree is compared with the rebuilt one, and the exit root of the network in the latest global exit
+// root must be one of the rebuilt roots. The report contains the first divergence found. The data is read inside
+// dbTx if it is not nil.
+func (tc *TreeChecker) Verify(ctx context.Context, networkID uint, tID uint8, dbTx pgx.Tx) (*TreeReport, error) {
+	var (
+		exitRoot      *common.Hash
+		exitRootFound bool
+	)
+	ger, err := tc.storage.GetLatestL1SyncedExitRoot(ctx, dbTx)
+	if err != nil {
+		if err != gerror.ErrStorageNotFound {
+			return nil, err
+		}
+	} else {
+		exitRoot, err = tc.localExitRoot(ctx, networkID, tID, ger, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		exitRootFound = exitRoot != nil && *exitRoot == zeroHashes[tc.height]
+	}
+
+	mt := tc.emptyTree(tID)
+	report := &TreeReport{
+		NetworkID: networkID,
+		Root:      mt.root,
+	}
+	for {
+		deposits, err := tc.storage.GetNetworkDeposits(ctx, networkID, mt.count, checkerPageSize, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		leaves, missing := depositLeaves(deposits, mt.count)
+		if len(leaves) > 0 {
+			update := mt.computeLeaves(mt.count, mt.frontier, leaves, true)
+			depositCnts, roots, err := tc.store.GetRoots(ctx, tID, mt.count, mt.count+uint(len(leaves)), dbTx)
+			if err != nil {
+				return nil, err
+			}
+			for i, depositCnt := range depositCnts {
+				expectedRoot := update.roots[depositCnt-mt.count-1]
+				if !bytes.Equal(roots[i], expectedRoot) {
+					report.DepositCount = mt.count
+					report.Divergence = &TreeDivergence{
+						DepositCount: depositCnt,
+						StoredRoot:   common.BytesToHash(roots[i]),
+						ExpectedRoot: common.BytesToHash(expectedRoot),
+						Reason:       "stored root doesn't match the rebuilt root",
+					}
+					return report, nil
+				}
+			}
+			// Every deposit of the mainnet updates the global exit root, so all its roots must be stored
+			if networkID == MainNetworkID && len(depositCnts) != len(leaves) {
+				for i, root := range update.roots {
+					if i >= len(depositCnts) || depositCnts[i] != update.depositCnts[i] {
+						report.DepositCount = mt.count
+						report.Divergence = &TreeDivergence{
+							DepositCount: update.depositCnts[i],
+							ExpectedRoot: common.BytesToHash(root),
+							Reason:       "root is not stored",
+						}
+						return report, nil
+					}
+				}
+			}
+			if exitRoot != nil && !exitRootFound {
+				for _, root := range update.roots {
+					if bytes.Equal(root, exitRoot[:]) {
+						exitRootFound = true
+						break
+					}
+				}
+			}
+			mt.count, mt.root, mt.frontier = update.depositCnts[len(update.depositCnts)-1], update.root, update.frontier
+			report.DepositCount, report.Root = mt.count, mt.root
+		}
+		if missing {
+			report.Divergence = &TreeDivergence{
+				DepositCount: mt.count,
+				Reason:       fmt.Sprintf("deposit %d is missing", mt.count),
+			}
+			return report, nil
+		}
+		if len(deposits) < checkerPageSize {
+			break
+		}
+	}
+
+	depositCnts, roots, err := tc.store.GetRoots(ctx, tID, mt.count, math.MaxUint32, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(depositCnts) > 0 {
+		report.Divergence = &TreeDivergence{
+			DepositCount: depositCnts[0],
+			StoredRoot:   common.BytesToHash(roots[0]),
+			Reason:       "root is stored beyond the last deposit",
+		}
+		return report, nil
+	}
+	root, err := tc.store.GetRoot(ctx, mt.count, tID, dbTx)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return nil, err
+	}
+	if !bytes.Equal(root, mt.root[:]) {
+		report.Divergence = &TreeDivergence{
+			DepositCount: mt.count,
+			StoredRoot:   common.BytesToHash(root),
+			ExpectedRoot: mt.root,
+			Reason:       "last root doesn't match the rebuilt root",
+		}
+		return report, nil
+	}
+	if exitRoot != nil && !exitRootFound {
+		report.Divergence = &TreeDivergence{
+			DepositCount: mt.count,
+			StoredRoot:   *exitRoot,
+			Reason:       "exit root of the latest global exit root is not a root of the rebuilt tree",
+		}
+	}
+	return report, nil
+}
+
+// localExitRoot returns the exit root of the network included in the global exit root, or nil if the rollup exit
+// root was computed without the network.
+func (tc *TreeChecker) localExitRoot(ctx context.Context, networkID uint, tID uint8, ger *etherman.GlobalExitRoot, dbTx pgx.Tx) (*common.Hash, error) {
+	if networkID == MainNetworkID {
+		return &ger.ExitRoots[0], nil
+	}
+	leaves, err := tc.storage.GetRollupExitLeaves(ctx, ger.ExitRoots[1], dbTx)
+	if err != nil {
+		if err == gerror.ErrStorageNotFound {
+			if tID == verifiedRollupTreeIndex {
+				// The rollup exit root is the local exit root of the verified rollup
+				return &ger.ExitRoots[1], nil
+			}
+			return nil, nil
+		}
+		return nil, err
+	}
+	if leaf := rollupLeaf(leaves, networkID); leaf != nil {
+		return &leaf.LocalExitRoot, nil
+	}
+	return nil, nil
+}
+
+// Rebuild rewrites the stored exit tree of the network from its deposits in a db transaction. The roots are
+// stored as the synchronizer does, every root for the mainnet and the root of every block for the rest of networks.
+// The bridge service must be stopped, its in-memory trees are not updated.
+func (tc *TreeChecker) Rebuild(ctx context.Context, networkID uint, tID uint8) error {
+	dbTx, err := tc.storage.BeginDBTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	err = tc.rebuild(ctx, networkID, tID, dbTx)
+	if err != nil {
+		discardStore(tc.store, dbTx)
+		rollbackErr := tc.storage.Rollback(ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back the tree rebuild. RollbackErr: %s, err: %s", networkID, rollbackErr.Error(), err.Error())
+		}
+		return err
+	}
+	err = tc.storage.Commit(ctx, dbTx)
+	if err != nil {
+		discardStore(tc.store, dbTx)
+		return err
+	}
+	return commitStore(ctx, tc.store, dbTx)
+}
+
+func (tc *TreeChecker) rebuild(ctx context.Context, networkID uint, tID uint8, dbTx pgx.Tx) error {
+	err := tc.store.ResetMT(ctx, 0, tID, dbTx)
+	if err != nil {
+		return err
+	}
+	// The empty tree must be complete to start adding leaves
+	var (
+		keys   [][]byte
+		values [][][]byte
+	)
+	for h := uint8(0); h < tc.height; h++ {
+		keys = append(keys, zeroHashes[h+1][:])
+		values = append(values, [][]byte{zeroHashes[h][:], zeroHashes[h][:]})
+	}
+	err = tc.store.BulkSet(ctx, keys, values, dbTx)
+	if err != nil {
+		return err
+	}
+	_, err = tc.store.GetRoot(ctx, 0, tID, dbTx)
+	if err == gerror.ErrStorageNotFound {
+		err = tc.store.SetRoot(ctx, zeroHashes[tc.height][:], 0, tID, dbTx)
+	}
+	if err != nil {
+		return err
+	}
+
+	mt := tc.emptyTree(tID)
+	for {
+		depositCnt, _, _ := mt.state(dbTx)
+		deposits, err := tc.storage.GetNetworkDeposits(ctx, networkID, depositCnt, checkerPageSize, dbTx)
+		if err != nil {
+			return err
+		}
+		leaves, missing := depositLeaves(deposits, depositCnt)
+		err = addBlockLeaves(ctx, mt, networkID, deposits, leaves, dbTx)
+		if err != nil {
+			return err
+		}
+		if missing {
+			return fmt.Errorf("networkID: %d, deposit %d is missing", networkID, depositCnt+uint(len(leaves)))
+		}
+		if len(deposits) < checkerPageSize {
+			return nil
+		}
+	}
+}
+
+// addBlockLeaves adds the leaves of the deposits to the tree by block like the synchronizer, so the roots of the
+// rollups are stored at the end of every block.
+func addBlockLeaves(ctx context.Context, mt *MerkleTree, networkID uint, deposits []*etherman.Deposit, leaves [][KeyLen]byte, dbTx pgx.Tx) error {
+	for start := 0; start < len(leaves); {
+		end := start + 1
+		for end < len(leaves) && deposits[end].BlockID == deposits[start].BlockID {
+			end++
+		}
+		err := mt.addLeaves(ctx, leaves[start:end], networkID == MainNetworkID, dbTx)
+		if err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+// depositLeaves returns the leaves of the consecutive deposits starting at depositCnt and whether a deposit is missing.
+func depositLeaves(deposits []*etherman.Deposit, depositCnt uint) ([][KeyLen]byte, bool) {
+	leaves := make([][KeyLen]byte, 0, len(deposits))
+	for i, deposit := range deposits {
+		if deposit.DepositCount != depositCnt+uint(i) {
+			return leaves, true
+		}
+		leaves = append(leaves, HashDeposit(deposit))
+	}
+	return leaves, false
+}
@@ -0,0 +1,79 @@
+package bridgectrl
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/test/vectors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeChecker(t *testing.T) {
+	data, err := os.ReadFile("test/vectors/src/deposit-raw.json")
+	require.NoError(t, err)
+
+	var testVectors []vectors.DepositVectorRaw
+	err = json.Unmarshal(data, &testVectors)
+	require.NoError(t, err)
+
+	cfg := Config{
+		Height: uint8(32), //nolint:gomnd
+	}
+
+	ctx := context.TODO()
+	store := newMemoryStorage()
+	mtStore := kvstorage.NewMemoryStorage()
+	id := uint64(1)
+
+	bt, err := NewBridgeController(cfg, []uint{0, 1000}, store, mtStore)
+	require.NoError(t, err)
+
+	var deposits []*etherman.Deposit
+	for i, testVector := range testVectors {
+		amount, _ := new(big.Int).SetString(testVector.Amount, 0)
+		deposit := &etherman.Deposit{
+			OriginalNetwork:    testVector.OriginalNetwork,
+			OriginalAddress:    common.HexToAddress(testVector.TokenAddress),
+			Amount:             amount,
+			DestinationNetwork: testVector.DestinationNetwork,
+			DestinationAddress: common.HexToAddress(testVector.DestinationAddress),
+			BlockID:            id,
+			DepositCount:       uint(i),
+			Metadata:           common.FromHex(testVector.Metadata),
+		}
+		err = store.AddDeposit(ctx, deposit, nil)
+		require.NoError(t, err)
+		deposits = append(deposits, deposit)
+	}
+	err = bt.AddDeposits(ctx, deposits, nil)
+	require.NoError(t, err)
+
+	checker := NewTreeChecker(cfg, store, mtStore)
+	report, err := checker.Verify(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Nil(t, report.Divergence)
+	assert.Equal(t, uint(len(deposits)), report.DepositCount)
+	assert.Equal(t, common.Hash(bt.exitTrees[0].root), report.Root)
+
+	// Remove the roots of the last deposits as an interrupted sync would do
+	err = mtStore.ResetMT(ctx, 2, 0, nil)
+	require.NoError(t, err)
+	report, err = checker.Verify(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	require.NotNil(t, report.Divergence)
+	assert.Equal(t, uint(3), report.Divergence.DepositCount)
+
+	err = checker.Rebuild(ctx, 0, 0)
+	require.NoError(t, err)
+	report, err = checker.Verify(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Nil(t, report.Divergence)
+	assert.Equal(t, common.Hash(bt.exitTrees[0].root), report.Root)
+}
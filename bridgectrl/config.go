@@ -1,9 +1,26 @@
 package bridgectrl
 
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+)
+
 // Config is state config
 type Config struct {
-	// Store is the kind of storage in the bridge tree
+	// Store is the kind of storage in the bridge tree: "postgres" keeps it in the bridge database and "bbolt" in an
+	// embedded database file
 	Store string
+	// StorePath is the path of the database file of the embedded stores
+	StorePath string
 	// Height is the depth of the merkle tree
 	Height uint8
+	// GCInterval is the interval to remove the merkle tree nodes unreachable from any root. 0 disables it.
+	GCInterval types.Duration
+	// ProofCache is the cache of the merkle proofs served by the API
+	ProofCache ProofCacheConfig
+}
+
+// ProofCacheConfig is the config of the proof cache
+type ProofCacheConfig struct {
+	// Size is the maximum number of cached proofs. 0 disables the cache
+	Size int
 }
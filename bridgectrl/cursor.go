@@ -0,0 +1,49 @@
+package bridgectrl
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+)
+
+// cursorVersion is the first byte of the encoded cursors, so the format can be changed without misreading old cursors.
+const cursorVersion = 1
+
+// encodeCursor returns the opaque string of the cursor sent to the clients.
+func encodeCursor(cursor *etherman.Cursor) string {
+	buf := make([]byte, 1, 1+3*binary.MaxVarintLen64) //nolint:gomnd
+	buf[0] = cursorVersion
+	tmp := make([]byte, binary.MaxVarintLen64)
+	for _, value := range []uint64{cursor.BlockID, uint64(cursor.NetworkID), uint64(cursor.Index)} {
+		n := binary.PutUvarint(tmp, value)
+		buf = append(buf, tmp[:n]...)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// decodeCursor decodes a cursor returned by encodeCursor. An empty string is no cursor.
+func decodeCursor(s string) (*etherman.Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(buf) == 0 || buf[0] != cursorVersion {
+		return nil, gerror.ErrInvalidCursor
+	}
+	buf = buf[1:]
+	var values [3]uint64
+	for i := range values {
+		value, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return nil, gerror.ErrInvalidCursor
+		}
+		values[i] = value
+		buf = buf[n:]
+	}
+	if len(buf) != 0 {
+		return nil, gerror.ErrInvalidCursor
+	}
+	return &etherman.Cursor{BlockID: values[0], NetworkID: uint(values[1]), Index: uint(values[2])}, nil
+}
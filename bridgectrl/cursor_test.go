@@ -0,0 +1,26 @@
+package bridgectrl
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	cursor := &etherman.Cursor{BlockID: 1 << 40, NetworkID: 1, Index: 300}
+	decoded, err := decodeCursor(encodeCursor(cursor))
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	decoded, err = decodeCursor("")
+	require.NoError(t, err)
+	assert.Nil(t, decoded)
+
+	for _, s := range []string{"not a cursor", "AA", encodeCursor(cursor)[:4], encodeCursor(cursor) + "AA"} {
+		_, err = decodeCursor(s)
+		assert.ErrorIs(t, err, gerror.ErrInvalidCursor, s)
+	}
+}
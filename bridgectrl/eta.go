@@ -0,0 +1,87 @@
+package bridgectrl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+)
+
+const (
+	// latencyWindow is the age of the deposits the latencies are learned from
+	latencyWindow = 7 * 24 * time.Hour
+	// latencyRefreshInterval is how long the latencies are cached
+	latencyRefreshInterval = time.Minute
+	// minLatencySamples is the number of deposits of a network required to estimate the time of its pending deposits
+	minLatencySamples = 10
+)
+
+// latencyEstimator estimates when the pending deposits will be claimable from the time the recent deposits of their
+// network took. For the mainnet deposits it is the time until the global exit root including them is synced in the
+// rollup, and for the rollup deposits the time until their batch is verified.
+type latencyEstimator struct {
+	storage   latencyStorage
+	lock      sync.Mutex
+	latencies []*etherman.NetworkLatency
+	updatedAt time.Time
+}
+
+func newLatencyEstimator(storage latencyStorage) *latencyEstimator {
+	return &latencyEstimator{storage: storage}
+}
+
+// getLatencies returns the latencies of the networks with claimable deposits in the window, refreshed from the
+// storage when they are older than the refresh interval.
+func (e *latencyEstimator) getLatencies(ctx context.Context) ([]*etherman.NetworkLatency, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.latencies != nil && time.Since(e.updatedAt) < latencyRefreshInterval {
+		return e.latencies, nil
+	}
+	latencies, err := e.storage.GetClaimableLatencies(ctx, time.Now().Add(-latencyWindow), nil)
+	if err != nil {
+		return nil, err
+	}
+	if latencies == nil {
+		latencies = []*etherman.NetworkLatency{}
+	}
+	e.latencies, e.updatedAt = latencies, time.Now()
+	return latencies, nil
+}
+
+// estimate returns the latency of the network, or nil if there aren't enough recent deposits to estimate it.
+func (e *latencyEstimator) estimate(ctx context.Context, networkID uint) (*etherman.NetworkLatency, error) {
+	latencies, err := e.getLatencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, latency := range latencies {
+		if latency.NetworkID == networkID {
+			if latency.Samples < minLatencySamples {
+				return nil, nil
+			}
+			return latency, nil
+		}
+	}
+	return nil, nil
+}
+
+// depositEtaToPb returns the estimated time of the deposit from the latency of its network. The times already passed
+// are the current time, as the deposit is late.
+func depositEtaToPb(deposit *etherman.Deposit, latency *etherman.NetworkLatency, now time.Time) *pb.DepositEta {
+	at := func(d time.Duration) int64 {
+		t := deposit.ReceivedAt.Add(d)
+		if t.Before(now) {
+			t = now
+		}
+		return t.Unix()
+	}
+	return &pb.DepositEta{
+		EstimatedTime: at(latency.P50),
+		EarliestTime:  at(latency.P10),
+		LatestTime:    at(latency.P90),
+		Samples:       latency.Samples,
+	}
+}
@@ -0,0 +1,51 @@
+package bridgectrl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type latencyStorageFunc func() ([]*etherman.NetworkLatency, error)
+
+func (f latencyStorageFunc) GetClaimableLatencies(ctx context.Context, since time.Time, dbTx pgx.Tx) ([]*etherman.NetworkLatency, error) {
+	return f()
+}
+
+func TestLatencyEstimator(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	e := newLatencyEstimator(latencyStorageFunc(func() ([]*etherman.NetworkLatency, error) {
+		calls++
+		return []*etherman.NetworkLatency{
+			{NetworkID: 0, Samples: minLatencySamples, P10: time.Minute, P50: 2 * time.Minute, P90: 10 * time.Minute},
+			{NetworkID: 1, Samples: minLatencySamples - 1, P10: time.Hour, P50: time.Hour, P90: time.Hour},
+		}, nil
+	}))
+
+	latency, err := e.estimate(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Minute, latency.P50)
+	// The networks without enough deposits aren't estimated
+	latency, err = e.estimate(ctx, 1)
+	require.NoError(t, err)
+	require.Nil(t, latency)
+	latency, err = e.estimate(ctx, 2)
+	require.NoError(t, err)
+	require.Nil(t, latency)
+	// The latencies are cached
+	require.Equal(t, 1, calls)
+
+	now := time.Now()
+	deposit := &etherman.Deposit{ReceivedAt: now.Add(-5 * time.Minute)}
+	eta := depositEtaToPb(deposit, &etherman.NetworkLatency{Samples: 10, P10: time.Minute, P50: 2 * time.Minute, P90: 10 * time.Minute}, now)
+	// The passed times are the current time
+	require.Equal(t, now.Unix(), eta.EarliestTime)
+	require.Equal(t, now.Unix(), eta.EstimatedTime)
+	require.Equal(t, now.Add(5*time.Minute).Unix(), eta.LatestTime)
+	require.Equal(t, uint64(10), eta.Samples)
+}
@@ -0,0 +1,68 @@
+package bridgectrl
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-node/log"
+)
+
+// gcBatchSize is the maximum number of nodes removed at once by the garbage collector.
+const gcBatchSize = 10000
+
+// GarbageCollector removes the merkle tree nodes which are not reachable from any stored root.
+// These nodes are left behind by the leaves removed in a reorg.
+type GarbageCollector struct {
+	storage  gcStorage
+	interval time.Duration
+}
+
+// NewGarbageCollector creates new GarbageCollector.
+func NewGarbageCollector(cfg Config, mtStore interface{}) *GarbageCollector {
+	return &GarbageCollector{
+		storage:  mtStore.(gcStorage),
+		interval: cfg.GCInterval.Duration,
+	}
+}
+
+// Collect removes the unreachable nodes and returns the number of removed nodes. The unreachable nodes are found
+// without blocking the writers, then they are removed in batches, each one blocking the writes of new nodes for a
+// short time. The nodes written again meanwhile are kept, so it is safe to run it while syncing.
+func (gc *GarbageCollector) Collect(ctx context.Context) (uint64, error) {
+	keys, versions, err := gc.storage.GetUnreachableNodes(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for start := 0; start < len(keys); start += gcBatchSize {
+		end := start + gcBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		count, err := gc.storage.DeleteNodes(ctx, keys[start:end], versions[start:end], nil)
+		if err != nil {
+			return total, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// Start runs Collect periodically until the context is done.
+func (gc *GarbageCollector) Start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("merkle tree garbage collector ctx done")
+			return
+		case <-time.After(gc.interval):
+			start := time.Now()
+			count, err := gc.Collect(ctx)
+			if err != nil {
+				log.Warn("error collecting the unreachable merkle tree nodes: ", err)
+				continue
+			}
+			log.Infof("%d unreachable merkle tree nodes removed in %s", count, time.Since(start))
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package bridgectrl
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/test/vectors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGarbageCollector(t *testing.T) {
+	data, err := os.ReadFile("test/vectors/src/mt-bridge/claim-vectors.json")
+	require.NoError(t, err)
+
+	var mtTestVectors []vectors.MTClaimVectorRaw
+	err = json.Unmarshal(data, &mtTestVectors)
+	require.NoError(t, err)
+	testVector := mtTestVectors[len(mtTestVectors)-1]
+
+	ctx := context.Background()
+	store := kvstorage.NewMemoryStorage()
+
+	mt, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
+	require.NoError(t, err)
+
+	var leaves [][KeyLen]byte
+	for li, leaf := range testVector.Deposits {
+		amount, result := new(big.Int).SetString(leaf.Amount, 0)
+		require.True(t, result)
+
+		deposit := &etherman.Deposit{
+			OriginalNetwork:    leaf.OriginalNetwork,
+			OriginalAddress:    common.HexToAddress(leaf.TokenAddress),
+			Amount:             amount,
+			DestinationNetwork: leaf.DestinationNetwork,
+			DestinationAddress: common.HexToAddress(leaf.DestinationAddress),
+			BlockNumber:        0,
+			DepositCount:       uint(li + 1),
+			Metadata:           common.FromHex(leaf.Metadata),
+		}
+		leaves = append(leaves, HashDeposit(deposit))
+	}
+	err = mt.addLeaves(ctx, leaves, true, nil)
+	require.NoError(t, err)
+
+	gc := NewGarbageCollector(Config{}, store)
+	// All the nodes are reachable before the reorg
+	count, err := gc.Collect(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+
+	// The unreachable nodes written again before they are removed are kept
+	err = mt.resetLeaf(ctx, 1, nil)
+	require.NoError(t, err)
+	keys, versions, err := store.GetUnreachableNodes(ctx, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, keys)
+	err = mt.addLeaves(ctx, leaves[1:], true, nil)
+	require.NoError(t, err)
+	count, err = store.DeleteNodes(ctx, keys, versions, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), count)
+	assert.Equal(t, testVector.ExpectedRoot[2:], hex.EncodeToString(mt.root[:]))
+
+	err = mt.resetLeaf(ctx, 1, nil)
+	require.NoError(t, err)
+	count, err = gc.Collect(ctx)
+	require.NoError(t, err)
+	assert.Greater(t, count, uint64(0))
+
+	// The retained root is still complete and the reorged leaves can be added again
+	_, err = mt.getSiblings(ctx, 0, mt.root, nil)
+	require.NoError(t, err)
+	err = mt.addLeaves(ctx, leaves[1:], true, nil)
+	require.NoError(t, err)
+	assert.Equal(t, testVector.ExpectedRoot[2:], hex.EncodeToString(mt.root[:]))
+
+	prooves, err := mt.getSiblings(ctx, testVector.Index, mt.root, nil)
+	require.NoError(t, err)
+	for i, proof := range prooves {
+		assert.Equal(t, testVector.MerkleProof[i][2:], hex.EncodeToString(proof[:]))
+	}
+}
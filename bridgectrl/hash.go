@@ -33,7 +33,8 @@ func generateZeroHashes(height uint8) [][KeyLen]byte {
 	return zeroHashes
 }
 
-func hashDeposit(deposit *etherman.Deposit) [KeyLen]byte {
+// HashDeposit returns the leaf hash of the deposit in the exit tree.
+func HashDeposit(deposit *etherman.Deposit) [KeyLen]byte {
 	var res [KeyLen]byte
 	origNet := make([]byte, 4) //nolint:gomnd
 	binary.BigEndian.PutUint32(origNet, uint32(deposit.OriginalNetwork))
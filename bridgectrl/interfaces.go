@@ -2,6 +2,7 @@ package bridgectrl
 
 import (
 	"context"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/ethereum/go-ethereum/common"
@@ -12,28 +13,87 @@ import (
 type merkleTreeStore interface {
 	Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error)
 	Set(ctx context.Context, key []byte, value [][]byte, dbTx pgx.Tx) error
+	BulkSet(ctx context.Context, keys [][]byte, values [][][]byte, dbTx pgx.Tx) error
 	ResetMT(ctx context.Context, depositCount uint, network uint8, dbTx pgx.Tx) error
 	GetRoot(ctx context.Context, depositCount uint, network uint8, dbTx pgx.Tx) ([]byte, error)
 	SetRoot(ctx context.Context, root []byte, depositCount uint, network uint8, dbTx pgx.Tx) error
+	BulkSetRoot(ctx context.Context, roots [][]byte, depositCounts []uint, network uint8, dbTx pgx.Tx) error
 	GetLastDepositCount(ctx context.Context, network uint8, dbTx pgx.Tx) (uint, error)
+	GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error)
+	GetRoots(ctx context.Context, network uint8, fromDepositCnt uint, toDepositCnt uint, dbTx pgx.Tx) ([]uint, [][]byte, error)
+}
+
+// nodeReader reads the nodes of the merkle trees
+type nodeReader interface {
+	Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error)
+}
+
+// txMerkleTreeStore is implemented by the merkle tree stores which are not part of the db transaction.
+// The changes written inside dbTx are kept by the store until CommitTx is called once dbTx is committed.
+type txMerkleTreeStore interface {
+	CommitTx(ctx context.Context, dbTx pgx.Tx) error
+	DiscardTx(dbTx pgx.Tx)
+}
+
+// gcStorage interface for the merkle tree garbage collector
+type gcStorage interface {
+	GetUnreachableNodes(ctx context.Context, dbTx pgx.Tx) ([][]byte, []uint64, error)
+	DeleteNodes(ctx context.Context, keys [][]byte, versions []uint64, dbTx pgx.Tx) (uint64, error)
+}
+
+// checkerStorage interface for the exit tree checker
+type checkerStorage interface {
+	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Commit(ctx context.Context, dbTx pgx.Tx) error
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
+	GetNetworkDeposits(ctx context.Context, networkID uint, fromDepositCnt uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetRollupExitLeaves(ctx context.Context, rollupExitRoot common.Hash, dbTx pgx.Tx) ([]*etherman.RollupExitLeaf, error)
+}
+
+// notifierStorage interface for the deposit notifier
+type notifierStorage interface {
+	GetDeposit(ctx context.Context, depositCnt uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error)
+	GetNetworkDeposits(ctx context.Context, networkID uint, fromDepositCnt uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetReadyDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error)
+}
+
+// latencyStorage interface for the latency estimator
+type latencyStorage interface {
+	GetClaimableLatencies(ctx context.Context, since time.Time, dbTx pgx.Tx) ([]*etherman.NetworkLatency, error)
 }
 
 // bridgeStorage interface for the Bridge Tree
 type bridgeStorage interface {
-	GetRoot(ctx context.Context, depositCnt uint, network uint8, dbTx pgx.Tx) ([]byte, error)
-	GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error)
+	BeginSnapshotDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
 	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
 	GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetExitRootByGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	AddRollupExitLeaves(ctx context.Context, rollupExitRoot common.Hash, leaves []*etherman.RollupExitLeaf, blockID uint64, dbTx pgx.Tx) error
+	GetRollupExitLeaves(ctx context.Context, rollupExitRoot common.Hash, dbTx pgx.Tx) ([]*etherman.RollupExitLeaf, error)
+	GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]*etherman.RollupExitLeaf, error)
 	AddGlobalExitRoot(ctx context.Context, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) error
-	GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, networkID *uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetClaimedDepositNetwork(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (uint, error)
+	GetNetworkDeposits(ctx context.Context, networkID uint, fromDepositCnt uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetNetworkDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error)
 }
 
 // BridgeServiceStorage interface for the Bridge Service.
 type BridgeServiceStorage interface {
-	GetClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Claim, error)
-	GetClaim(ctx context.Context, index uint, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error)
+	GetClaims(ctx context.Context, destAddr string, after *etherman.Cursor, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Claim, error)
+	GetClaim(ctx context.Context, index uint, networkID uint, sourceNetworkID uint, dbTx pgx.Tx) (*etherman.Claim, error)
 	GetClaimCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error)
 	GetDeposit(ctx context.Context, depositCnt uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error)
-	GetDeposits(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
-	GetDepositCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error)
+	GetDeposits(ctx context.Context, filter *etherman.DepositFilter, after *etherman.Cursor, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetDepositCount(ctx context.Context, filter *etherman.DepositFilter, dbTx pgx.Tx) (uint64, error)
+	GetDepositsByTxHash(ctx context.Context, txHash common.Hash, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	GetClaimsByTxHash(ctx context.Context, txHash common.Hash, dbTx pgx.Tx) ([]*etherman.Claim, error)
+	GetDepositTransitions(ctx context.Context, networkID uint, depositCnt uint, dbTx pgx.Tx) ([]*etherman.DepositTransition, error)
+	GetClaimableLatencies(ctx context.Context, since time.Time, dbTx pgx.Tx) ([]*etherman.NetworkLatency, error)
+	GetTokenStats(ctx context.Context, since []time.Time, dbTx pgx.Tx) ([]*etherman.TokenStats, error)
+	GetTokenWrappedByAddress(ctx context.Context, wrappedTokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetTokensWrapped(ctx context.Context, filter *etherman.TokenWrappedFilter, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error)
+	GetTokenWrappedCount(ctx context.Context, filter *etherman.TokenWrappedFilter, dbTx pgx.Tx) (uint64, error)
 }
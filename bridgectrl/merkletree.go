@@ -3,8 +3,10 @@ package bridgectrl
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
 )
 
 // zeroHashes is the pre-calculated zero hash array
@@ -21,6 +23,21 @@ type MerkleTree struct {
 	count uint
 	// root is the value of the root node, count and root are only used in the synchronizer side
 	root [KeyLen]byte
+	// frontier keeps the right-most filled subtree hash of each level, it is the left sibling of the next leaf path
+	frontier [][KeyLen]byte
+	// pending is the state written inside a db transaction which is not committed yet
+	pending *pendingState
+	// lock is held for writing while the committed state and the store are updated, and for reading while the
+	// proofs are generated, so a proof never mixes the nodes of different states of the store
+	lock sync.RWMutex
+}
+
+// pendingState keeps the count, root and frontier of the tree modified inside dbTx
+type pendingState struct {
+	dbTx     pgx.Tx
+	count    uint
+	root     [KeyLen]byte
+	frontier [][KeyLen]byte
 }
 
 func init() {
@@ -62,16 +79,27 @@ func NewMerkleTree(ctx context.Context, store merkleTreeStore, height, network u
 	}
 	copy(mtRoot[:], root)
 
-	return &MerkleTree{
+	mt := &MerkleTree{
 		store:   store,
 		network: network,
 		height:  height,
 		count:   depositCnt,
 		root:    mtRoot,
-	}, nil
+	}
+	// Rebuild the frontier from the storage, the siblings of the next leaf are the filled subtrees
+	mt.frontier, err = mt.getSiblings(ctx, depositCnt, mtRoot, nil)
+	if err != nil {
+		return nil, err
+	}
+	return mt, nil
+}
+
+func (mt *MerkleTree) getSiblings(ctx context.Context, index uint, root [KeyLen]byte, dbTx pgx.Tx) ([][KeyLen]byte, error) {
+	return mt.getSiblingsFrom(ctx, mt.store, index, root, dbTx)
 }
 
-func (mt *MerkleTree) getSiblings(ctx context.Context, index uint, root [KeyLen]byte) ([][KeyLen]byte, error) {
+// getSiblingsFrom returns the siblings of the leaf reading the nodes from the reader.
+func (mt *MerkleTree) getSiblingsFrom(ctx context.Context, nodes nodeReader, index uint, root [KeyLen]byte, dbTx pgx.Tx) ([][KeyLen]byte, error) {
 	var (
 		left, right [KeyLen]byte
 		siblings    [][KeyLen]byte
@@ -80,7 +108,7 @@ func (mt *MerkleTree) getSiblings(ctx context.Context, index uint, root [KeyLen]
 	cur := root
 	// It starts in height-1 because 0 is the level of the leafs
 	for h := mt.height - 1; ; h-- {
-		value, err := mt.store.Get(ctx, cur[:], nil)
+		value, err := nodes.Get(ctx, cur[:], dbTx)
 		if err != nil {
 			return nil, fmt.Errorf("height: %d, cur: %v, error: %w", h, cur, err)
 		}
@@ -131,53 +159,212 @@ func (mt *MerkleTree) getSiblings(ctx context.Context, index uint, root [KeyLen]
 	return siblings, nil
 }
 
-func (mt *MerkleTree) addLeaf(ctx context.Context, leaf [KeyLen]byte) error {
-	var parent [KeyLen]byte
+// state returns the count, root and frontier of the tree as seen from inside dbTx. The lock is taken as the
+// committed state is updated by the synchronizer while the API reads it.
+func (mt *MerkleTree) state(dbTx pgx.Tx) (uint, [KeyLen]byte, [][KeyLen]byte) {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+	if dbTx != nil && mt.pending != nil && mt.pending.dbTx == dbTx {
+		return mt.pending.count, mt.pending.root, mt.pending.frontier
+	}
+	return mt.count, mt.root, mt.frontier
+}
+
+// setState updates the count, root and frontier of the tree. If dbTx is nil the changes are already persisted,
+// otherwise they are kept as pending until the transaction is committed.
+func (mt *MerkleTree) setState(count uint, root [KeyLen]byte, frontier [][KeyLen]byte, dbTx pgx.Tx) {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+	if dbTx == nil {
+		mt.count = count
+		mt.root = root
+		mt.frontier = frontier
+		mt.pending = nil
+		return
+	}
+	if mt.pending != nil && mt.pending.dbTx != dbTx {
+		// The previous transaction was rolled back
+		discardStore(mt.store, mt.pending.dbTx)
+	}
+	mt.pending = &pendingState{
+		dbTx:     dbTx,
+		count:    count,
+		root:     root,
+		frontier: frontier,
+	}
+}
+
+// commit applies the pending state written inside dbTx. It must be called once dbTx is committed.
+// The pending state of a rolled back transaction is discarded when a new transaction modifies the tree.
+func (mt *MerkleTree) commit(ctx context.Context, dbTx pgx.Tx) error {
+	mt.lock.Lock()
+	defer mt.lock.Unlock()
+	err := commitStore(ctx, mt.store, dbTx)
+	if err != nil {
+		return err
+	}
+	if mt.pending == nil || mt.pending.dbTx != dbTx {
+		return nil
+	}
+	mt.count = mt.pending.count
+	mt.root = mt.pending.root
+	mt.frontier = mt.pending.frontier
+	mt.pending = nil
+	return nil
+}
+
+// committedRoot returns the root of the committed state of the tree.
+func (mt *MerkleTree) committedRoot() [KeyLen]byte {
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+	return mt.root
+}
+
+// commitStore writes the changes done inside dbTx to the store if it isn't part of the db transaction.
+func commitStore(ctx context.Context, store merkleTreeStore, dbTx pgx.Tx) error {
+	if txStore, ok := store.(txMerkleTreeStore); ok && dbTx != nil {
+		return txStore.CommitTx(ctx, dbTx)
+	}
+	return nil
+}
+
+// discardStore removes the changes done inside dbTx from the store if it isn't part of the db transaction.
+func discardStore(store merkleTreeStore, dbTx pgx.Tx) {
+	if txStore, ok := store.(txMerkleTreeStore); ok && dbTx != nil {
+		txStore.DiscardTx(dbTx)
+	}
+}
+
+// addLeaf appends the leaf to the tree. The path is computed from the in-memory frontier,
+// so no node is read from the storage and all the new nodes are written in one batch.
+func (mt *MerkleTree) addLeaf(ctx context.Context, leaf [KeyLen]byte, dbTx pgx.Tx) error {
+	return mt.addLeaves(ctx, [][KeyLen]byte{leaf}, true, dbTx)
+}
 
-	index := mt.count
-	cur := leaf
+// addLeaves appends a list of leaves to the tree writing all the nodes and roots in one batch.
+// If keepRoots is true, the root and the path of every leaf are stored so proofs can be generated
+// against any intermediate root. Otherwise, only the nodes reachable from the final root and the final root are stored.
+func (mt *MerkleTree) addLeaves(ctx context.Context, leaves [][KeyLen]byte, keepRoots bool, dbTx pgx.Tx) error {
+	if len(leaves) == 0 {
+		return nil
+	}
+	index, _, frontier := mt.state(dbTx)
+	update := mt.computeLeaves(index, frontier, leaves, keepRoots)
 
-	siblings, err := mt.getSiblings(ctx, index, mt.root)
+	err := mt.store.BulkSet(ctx, update.keys, update.values, dbTx)
 	if err != nil {
 		return err
 	}
+	err = mt.store.BulkSetRoot(ctx, update.roots, update.depositCnts, mt.network, dbTx)
+	if err != nil {
+		return err
+	}
+	mt.setState(update.depositCnts[len(update.depositCnts)-1], update.root, update.frontier, dbTx)
+	return nil
+}
+
+// treeUpdate keeps the nodes and roots computed when some leaves are appended to the tree
+type treeUpdate struct {
+	keys        [][]byte
+	values      [][][]byte
+	roots       [][]byte
+	depositCnts []uint
+	root        [KeyLen]byte
+	frontier    [][KeyLen]byte
+}
+
+// computeLeaves computes the nodes and roots of appending the leaves to the tree of index leaves with the
+// given frontier. It doesn't access the storage nor modify the tree.
+func (mt *MerkleTree) computeLeaves(index uint, curFrontier [][KeyLen]byte, leaves [][KeyLen]byte, keepRoots bool) *treeUpdate {
+	update := &treeUpdate{
+		frontier: make([][KeyLen]byte, len(curFrontier)),
+	}
+	frontier := update.frontier
+	copy(frontier, curFrontier)
+
+	stored := make(map[[KeyLen]byte]struct{})
+	setNode := func(parent, left, right [KeyLen]byte) {
+		if _, found := stored[parent]; found {
+			return
+		}
+		stored[parent] = struct{}{}
+		update.keys = append(update.keys, parent[:])
+		update.values = append(update.values, [][]byte{left[:], right[:]})
+	}
+
+	if keepRoots {
+		for i, leaf := range leaves {
+			cur := leaf
+			for h := uint8(0); h < mt.height; h++ {
+				var parent [KeyLen]byte
+				if (index+uint(i))&(1<<h) > 0 {
+					// The left sibling is the filled subtree of this level
+					parent = hash(frontier[h], cur)
+					setNode(parent, frontier[h], cur)
+				} else {
+					// The right sibling is empty, the current node becomes the filled subtree of this level
+					frontier[h] = cur
+					parent = hash(cur, zeroHashes[h])
+					setNode(parent, cur, zeroHashes[h])
+				}
+				cur = parent
+			}
+			update.root = cur
+			update.roots = append(update.roots, cur[:])
+			update.depositCnts = append(update.depositCnts, index+uint(i)+1)
+		}
+		return update
+	}
 
+	// The tree is built level by level, every parent node is computed only once with the final value of its children
+	end := index + uint(len(leaves))
+	level := leaves
+	lo := index
 	for h := uint8(0); h < mt.height; h++ {
-		if index&(1<<h) > 0 {
-			parent = hash(siblings[h], cur)
-			err := mt.store.Set(ctx, parent[:], [][]byte{siblings[h][:], cur[:]}, nil)
-			if err != nil {
-				return err
+		hi := lo + uint(len(level)) - 1
+		parents := make([][KeyLen]byte, 0, (hi>>1)-(lo>>1)+1)
+		for p := lo >> 1; p <= hi>>1; p++ {
+			left, right := frontier[h], zeroHashes[h]
+			if 2*p >= lo {
+				left = level[2*p-lo]
 			}
-		} else {
-			parent = hash(cur, siblings[h])
-			err := mt.store.Set(ctx, parent[:], [][]byte{cur[:], siblings[h][:]}, nil)
-			if err != nil {
-				return err
+			if 2*p+1 <= hi {
+				right = level[2*p+1-lo]
 			}
+			parent := hash(left, right)
+			setNode(parent, left, right)
+			parents = append(parents, parent)
 		}
-		cur = parent
+		// The left sibling of the next leaf path in this level is the last filled subtree
+		if (end>>h)&1 > 0 && (end>>h)-1 >= lo {
+			frontier[h] = level[(end>>h)-1-lo]
+		}
+		level = parents
+		lo >>= 1
 	}
-
-	// Set the root value
-	mt.root = cur
-	mt.count++
-	err = mt.store.SetRoot(ctx, cur[:], mt.count, mt.network, nil)
-	return err
+	update.root = level[0]
+	update.roots = append(update.roots, level[0][:])
+	update.depositCnts = append(update.depositCnts, end)
+	return update
 }
 
-func (mt *MerkleTree) resetLeaf(ctx context.Context, depositCount uint) error {
-	err := mt.store.ResetMT(ctx, depositCount, mt.network, nil)
+func (mt *MerkleTree) resetLeaf(ctx context.Context, depositCount uint, dbTx pgx.Tx) error {
+	err := mt.store.ResetMT(ctx, depositCount, mt.network, dbTx)
 	if err != nil {
 		return err
 	}
 
-	mt.count = depositCount
-	root, err := mt.store.GetRoot(ctx, depositCount, mt.network, nil)
+	var mtRoot [KeyLen]byte
+	root, err := mt.store.GetRoot(ctx, depositCount, mt.network, dbTx)
 	if err != nil {
 		return err
 	}
+	copy(mtRoot[:], root)
 
-	copy(mt.root[:], root)
+	frontier, err := mt.getSiblings(ctx, depositCount, mtRoot, dbTx)
+	if err != nil {
+		return err
+	}
+	mt.setState(depositCount, mtRoot, frontier, dbTx)
 	return nil
 }
@@ -11,10 +11,12 @@ import (
 	"runtime"
 	"testing"
 
-	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/test/vectors"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -67,7 +69,7 @@ func TestLeafHash(t *testing.T) {
 				DepositCount:       uint(ti + 1),
 				Metadata:           common.FromHex(testVector.Metadata),
 			}
-			leafHash := hashDeposit(deposit)
+			leafHash := HashDeposit(deposit)
 			assert.Equal(t, testVector.ExpectedHash[2:], hex.EncodeToString(leafHash[:]))
 		})
 	}
@@ -81,17 +83,11 @@ func TestMTAddLeaf(t *testing.T) {
 	err = json.Unmarshal(data, &mtTestVectors)
 	require.NoError(t, err)
 
-	dbCfg := pgstorage.NewConfigFromEnv()
-
 	ctx := context.WithValue(context.Background(), contextKeyNetwork, uint8(1)) //nolint
 
 	for ti, testVector := range mtTestVectors {
 		t.Run(fmt.Sprintf("Test vector %d", ti), func(t *testing.T) {
-			err = pgstorage.InitOrReset(dbCfg)
-			require.NoError(t, err)
-
-			store, err := pgstorage.NewPostgresStorage(dbCfg)
-			require.NoError(t, err)
+			store := kvstorage.NewMemoryStorage()
 
 			mt, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
 			require.NoError(t, err)
@@ -100,7 +96,7 @@ func TestMTAddLeaf(t *testing.T) {
 				leafValue, err := formatBytes32String(leaf[2:])
 				require.NoError(t, err)
 
-				err = mt.addLeaf(ctx, leafValue)
+				err = mt.addLeaf(ctx, leafValue, nil)
 				require.NoError(t, err)
 			}
 
@@ -119,8 +115,8 @@ func TestMTAddLeaf(t *testing.T) {
 				DepositCount:       uint(ti + 1),
 				Metadata:           common.FromHex(testVector.NewLeaf.Metadata),
 			}
-			leafHash := hashDeposit(deposit)
-			err = mt.addLeaf(ctx, leafHash)
+			leafHash := HashDeposit(deposit)
+			err = mt.addLeaf(ctx, leafHash, nil)
 			require.NoError(t, err)
 
 			assert.Equal(t, hex.EncodeToString(mt.root[:]), testVector.NewRoot[2:])
@@ -136,17 +132,11 @@ func TestMTGetProof(t *testing.T) {
 	err = json.Unmarshal(data, &mtTestVectors)
 	require.NoError(t, err)
 
-	dbCfg := pgstorage.NewConfigFromEnv()
-
 	ctx := context.WithValue(context.Background(), contextKeyNetwork, uint8(1)) //nolint
 
 	for ti, testVector := range mtTestVectors {
 		t.Run(fmt.Sprintf("Test vector %d", ti), func(t *testing.T) {
-			err = pgstorage.InitOrReset(dbCfg)
-			require.NoError(t, err)
-
-			store, err := pgstorage.NewPostgresStorage(dbCfg)
-			require.NoError(t, err)
+			store := kvstorage.NewMemoryStorage()
 
 			mt, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
 			require.NoError(t, err)
@@ -166,14 +156,14 @@ func TestMTGetProof(t *testing.T) {
 					Metadata:           common.FromHex(leaf.Metadata),
 				}
 
-				leafHash := hashDeposit(deposit)
-				err = mt.addLeaf(ctx, leafHash)
+				leafHash := HashDeposit(deposit)
+				err = mt.addLeaf(ctx, leafHash, nil)
 				require.NoError(t, err)
 			}
 
 			assert.Equal(t, hex.EncodeToString(mt.root[:]), testVector.ExpectedRoot[2:])
 
-			prooves, err := mt.getSiblings(ctx, testVector.Index, mt.root)
+			prooves, err := mt.getSiblings(ctx, testVector.Index, mt.root, nil)
 			require.NoError(t, err)
 
 			for i, proof := range prooves {
@@ -182,3 +172,249 @@ func TestMTGetProof(t *testing.T) {
 		})
 	}
 }
+
+func TestMTAddLeafInDBTx(t *testing.T) {
+	data, err := os.ReadFile("test/vectors/src/mt-bridge/root-vectors.json")
+	require.NoError(t, err)
+
+	var mtTestVectors []vectors.MTRootVectorRaw
+	err = json.Unmarshal(data, &mtTestVectors)
+	require.NoError(t, err)
+
+	store := kvstorage.NewMemoryStorage()
+	ctx := context.Background()
+	mt, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
+	require.NoError(t, err)
+	initRoot := mt.root
+
+	testVector := mtTestVectors[len(mtTestVectors)-1]
+	addLeaves := func(dbTx pgx.Tx) {
+		for _, leaf := range testVector.ExistingLeaves {
+			leafValue, err := formatBytes32String(leaf[2:])
+			require.NoError(t, err)
+
+			err = mt.addLeaf(ctx, leafValue, dbTx)
+			require.NoError(t, err)
+		}
+	}
+
+	// The rolled back leaves must not be visible neither in the storage nor in memory
+	var dbTx pgx.Tx = &testTx{}
+	addLeaves(dbTx)
+	require.Equal(t, initRoot, mt.root)
+	require.Equal(t, uint(0), mt.count)
+	store.DiscardTx(dbTx)
+	_, err = store.GetRoot(ctx, uint(len(testVector.ExistingLeaves)), 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	// The in-memory state is only updated after the commit
+	dbTx = &testTx{}
+	addLeaves(dbTx)
+	require.Equal(t, initRoot, mt.root)
+	require.NoError(t, mt.commit(ctx, dbTx))
+	assert.Equal(t, testVector.CurrentRoot[2:], hex.EncodeToString(mt.root[:]))
+	assert.Equal(t, uint(len(testVector.ExistingLeaves)), mt.count)
+	root, err := store.GetRoot(ctx, mt.count, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, mt.root[:], root)
+}
+
+func TestMTRebuildFrontier(t *testing.T) {
+	data, err := os.ReadFile("test/vectors/src/mt-bridge/root-vectors.json")
+	require.NoError(t, err)
+
+	var mtTestVectors []vectors.MTRootVectorRaw
+	err = json.Unmarshal(data, &mtTestVectors)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	for ti, testVector := range mtTestVectors {
+		t.Run(fmt.Sprintf("Test vector %d", ti), func(t *testing.T) {
+			store := kvstorage.NewMemoryStorage()
+
+			mt, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
+			require.NoError(t, err)
+
+			for _, leaf := range testVector.ExistingLeaves {
+				leafValue, err := formatBytes32String(leaf[2:])
+				require.NoError(t, err)
+
+				err = mt.addLeaf(ctx, leafValue, nil)
+				require.NoError(t, err)
+			}
+
+			// Restarting the tree must rebuild the same frontier from the storage
+			restartedMT, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
+			require.NoError(t, err)
+			assert.Equal(t, mt.count, restartedMT.count)
+			assert.Equal(t, mt.root, restartedMT.root)
+			for h := uint(0); h < uint(restartedMT.height); h++ {
+				if restartedMT.count&(1<<h) > 0 {
+					assert.Equal(t, mt.frontier[h], restartedMT.frontier[h])
+				}
+			}
+
+			amount, result := new(big.Int).SetString(testVector.NewLeaf.Amount, 0)
+			require.True(t, result)
+
+			deposit := &etherman.Deposit{
+				OriginalNetwork:    testVector.NewLeaf.OriginalNetwork,
+				OriginalAddress:    common.HexToAddress(testVector.NewLeaf.TokenAddress),
+				Amount:             amount,
+				DestinationNetwork: testVector.NewLeaf.DestinationNetwork,
+				DestinationAddress: common.HexToAddress(testVector.NewLeaf.DestinationAddress),
+				BlockNumber:        0,
+				DepositCount:       uint(ti + 1),
+				Metadata:           common.FromHex(testVector.NewLeaf.Metadata),
+			}
+			leafHash := HashDeposit(deposit)
+			err = restartedMT.addLeaf(ctx, leafHash, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, hex.EncodeToString(restartedMT.root[:]), testVector.NewRoot[2:])
+		})
+	}
+}
+
+func TestMTAddLeaves(t *testing.T) {
+	data, err := os.ReadFile("test/vectors/src/mt-bridge/claim-vectors.json")
+	require.NoError(t, err)
+
+	var mtTestVectors []vectors.MTClaimVectorRaw
+	err = json.Unmarshal(data, &mtTestVectors)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	for ti, testVector := range mtTestVectors {
+		for _, keepRoots := range []bool{true, false} {
+			t.Run(fmt.Sprintf("Test vector %d, keep roots %t", ti, keepRoots), func(t *testing.T) {
+				store := kvstorage.NewMemoryStorage()
+
+				mt, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
+				require.NoError(t, err)
+
+				var leaves [][KeyLen]byte
+				for li, leaf := range testVector.Deposits {
+					amount, result := new(big.Int).SetString(leaf.Amount, 0)
+					require.True(t, result)
+
+					deposit := &etherman.Deposit{
+						OriginalNetwork:    leaf.OriginalNetwork,
+						OriginalAddress:    common.HexToAddress(leaf.TokenAddress),
+						Amount:             amount,
+						DestinationNetwork: leaf.DestinationNetwork,
+						DestinationAddress: common.HexToAddress(leaf.DestinationAddress),
+						BlockNumber:        0,
+						DepositCount:       uint(li + 1),
+						Metadata:           common.FromHex(leaf.Metadata),
+					}
+					leaves = append(leaves, HashDeposit(deposit))
+				}
+
+				// The first leaf is added alone so the batch starts in the middle of the tree
+				err = mt.addLeaves(ctx, leaves[:1], keepRoots, nil)
+				require.NoError(t, err)
+				err = mt.addLeaves(ctx, leaves[1:], keepRoots, nil)
+				require.NoError(t, err)
+
+				assert.Equal(t, uint(len(leaves)), mt.count)
+				assert.Equal(t, testVector.ExpectedRoot[2:], hex.EncodeToString(mt.root[:]))
+
+				prooves, err := mt.getSiblings(ctx, testVector.Index, mt.root, nil)
+				require.NoError(t, err)
+				for i, proof := range prooves {
+					assert.Equal(t, testVector.MerkleProof[i][2:], hex.EncodeToString(proof[:]))
+				}
+
+				// Intermediate roots are only stored if they are requested
+				for depositCnt := uint(2); depositCnt < uint(len(leaves)); depositCnt++ {
+					_, err = store.GetRoot(ctx, depositCnt, 0, nil)
+					if keepRoots {
+						require.NoError(t, err)
+					} else {
+						require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestVerifyMerkleProof(t *testing.T) {
+	data, err := os.ReadFile("test/vectors/src/mt-bridge/claim-vectors.json")
+	require.NoError(t, err)
+
+	var mtTestVectors []vectors.MTClaimVectorRaw
+	err = json.Unmarshal(data, &mtTestVectors)
+	require.NoError(t, err)
+
+	for ti, testVector := range mtTestVectors {
+		t.Run(fmt.Sprintf("Test vector %d", ti), func(t *testing.T) {
+			leaf := testVector.Deposits[testVector.Index]
+			amount, result := new(big.Int).SetString(leaf.Amount, 0)
+			require.True(t, result)
+
+			deposit := &etherman.Deposit{
+				OriginalNetwork:    leaf.OriginalNetwork,
+				OriginalAddress:    common.HexToAddress(leaf.TokenAddress),
+				Amount:             amount,
+				DestinationNetwork: leaf.DestinationNetwork,
+				DestinationAddress: common.HexToAddress(leaf.DestinationAddress),
+				Metadata:           common.FromHex(leaf.Metadata),
+			}
+			var siblings [][KeyLen]byte
+			for _, proof := range testVector.MerkleProof {
+				siblings = append(siblings, common.HexToHash(proof))
+			}
+			root := common.HexToHash(testVector.ExpectedRoot)
+
+			assert.True(t, VerifyMerkleProof(HashDeposit(deposit), testVector.Index, siblings, root))
+			assert.False(t, VerifyMerkleProof(HashDeposit(deposit), testVector.Index+1, siblings, root))
+			siblings[0] = HashZero
+			assert.False(t, VerifyMerkleProof(HashDeposit(deposit), testVector.Index, siblings, root))
+		})
+	}
+}
+
+func TestMTConcurrentProofs(t *testing.T) {
+	ctx := context.Background()
+	store := kvstorage.NewMemoryStorage()
+	mt, err := NewMerkleTree(ctx, store, uint8(32), uint8(0))
+	require.NoError(t, err)
+
+	const leavesCount = 200
+	leaf := func(i int) [KeyLen]byte {
+		return hash(common.BigToHash(big.NewInt(int64(i + 1))))
+	}
+	require.NoError(t, mt.addLeaf(ctx, leaf(0), nil))
+
+	done := make(chan error)
+	go func() {
+		for i := 1; i < leavesCount; i++ {
+			if err := mt.addLeaf(ctx, leaf(i), nil); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+
+	// The proofs read while the leaves are being added must always match the root they were read for
+	for finished := false; !finished; {
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+			finished = true
+		default:
+		}
+		mt.lock.RLock()
+		count, root := mt.count, mt.root
+		siblings, err := mt.getSiblings(ctx, count-1, root, nil)
+		mt.lock.RUnlock()
+		require.NoError(t, err)
+		require.True(t, VerifyMerkleProof(leaf(int(count-1)), count-1, siblings, root))
+	}
+	assert.Equal(t, uint(leavesCount), mt.count)
+}
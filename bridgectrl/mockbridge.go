@@ -135,6 +135,11 @@ func MockBridgeCtrl(store *pgstorage.PostgresStorage) (*BridgeController, error)
 		if err != nil {
 			return nil, err
 		}
+		// The deposit is claimable with the trusted exit root
+		err = store.UpdateDepositStates(context.TODO(), deposit.NetworkID, deposit.DepositCount, deposit.DepositCount+1, etherman.DepositStateClaimable, 0, time.Now(), nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 	err = store.AddTokenWrapped(context.TODO(), &etherman.TokenWrapped{
 		OriginalNetwork:      0,
@@ -0,0 +1,318 @@
+package bridgectrl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// subscriptionBufferSize is the number of events waiting to be sent to a subscriber, the subscription is closed
+// if the subscriber doesn't keep up
+const subscriptionBufferSize = 100
+
+// DepositEventType is the kind of change of the status of a deposit.
+type DepositEventType uint8
+
+const (
+	// DepositIndexed is sent when the deposit is synced
+	DepositIndexed DepositEventType = iota + 1
+	// DepositReadyForClaim is sent when the deposit is included in the exit root used to claim it
+	DepositReadyForClaim
+	// DepositClaimed is sent when the claim of the deposit is synced
+	DepositClaimed
+	// DepositRemoved is sent when the deposit is removed by a reorg
+	DepositRemoved
+)
+
+// DepositEvent is a change of the status of a deposit.
+type DepositEvent struct {
+	Type    DepositEventType
+	Deposit *etherman.Deposit
+	// ClaimTxHash is only set in the DepositClaimed events
+	ClaimTxHash common.Hash
+}
+
+// DepositSubscriptionFilter selects the deposits of a subscription, by the destination address or by the
+// network and the deposit count.
+type DepositSubscriptionFilter struct {
+	DestinationAddress *common.Address
+	Deposit            *DepositKey
+}
+
+func (f DepositSubscriptionFilter) match(deposit *etherman.Deposit) bool {
+	if f.DestinationAddress != nil && *f.DestinationAddress != deposit.DestinationAddress {
+		return false
+	}
+	if f.Deposit != nil && (f.Deposit.NetworkID != deposit.NetworkID || f.Deposit.DepositCount != deposit.DepositCount) {
+		return false
+	}
+	return true
+}
+
+// DepositSubscription receives the events of the deposits matching its filter.
+type DepositSubscription struct {
+	filter   DepositSubscriptionFilter
+	events   chan *DepositEvent
+	notifier *DepositNotifier
+	err      error
+}
+
+// Events returns the channel of the events. It is closed when the subscription ends, Err returns the reason.
+func (s *DepositSubscription) Events() <-chan *DepositEvent {
+	return s.events
+}
+
+// Err returns the error which ended the subscription, it must be called once the events channel is closed.
+func (s *DepositSubscription) Err() error {
+	return s.err
+}
+
+// Unsubscribe ends the subscription.
+func (s *DepositSubscription) Unsubscribe() {
+	s.notifier.remove(s, nil)
+}
+
+// notification is a change stored by a synchronizer.
+type notification struct {
+	networkID       uint
+	deposits        []*etherman.Deposit
+	claims          []*etherman.Claim
+	globalExitRoots []*etherman.GlobalExitRoot
+	trusted         bool
+	reorg           bool
+}
+
+// DepositNotifier sends the changes of the status of the deposits to the subscribers. It is notified by the
+// synchronizers of the stored changes, so the deposits are only read from the storage once for all the subscribers.
+// The notifications are queued without limit, so the synchronizers are never blocked by the subscribers.
+type DepositNotifier struct {
+	bridgeCtrl *BridgeController
+	storage    notifierStorage
+
+	queueLock sync.Mutex
+	queue     []*notification
+	// queued is signaled when a notification is added to the queue
+	queued chan struct{}
+
+	lock          sync.Mutex
+	subscriptions map[*DepositSubscription]struct{}
+	// readyCnts is the number of ready for claim deposits of each network when the last events were sent, read from
+	// the states of the deposits. It is nil until it is read.
+	readyCnts map[uint]uint
+}
+
+// NewDepositNotifier creates a new DepositNotifier.
+func NewDepositNotifier(bridgeCtrl *BridgeController, storage interface{}) *DepositNotifier {
+	return &DepositNotifier{
+		bridgeCtrl:    bridgeCtrl,
+		storage:       storage.(notifierStorage),
+		queued:        make(chan struct{}, 1),
+		subscriptions: make(map[*DepositSubscription]struct{}),
+	}
+}
+
+// Subscribe starts a subscription to the deposits matching the filter.
+func (n *DepositNotifier) Subscribe(filter DepositSubscriptionFilter) *DepositSubscription {
+	sub := &DepositSubscription{
+		filter:   filter,
+		events:   make(chan *DepositEvent, subscriptionBufferSize),
+		notifier: n,
+	}
+	n.lock.Lock()
+	n.subscriptions[sub] = struct{}{}
+	n.lock.Unlock()
+	return sub
+}
+
+// remove ends the subscription with the error.
+func (n *DepositNotifier) remove(sub *DepositSubscription, err error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if _, found := n.subscriptions[sub]; !found {
+		return
+	}
+	delete(n.subscriptions, sub)
+	sub.err = err
+	close(sub.events)
+}
+
+// Start processes the changes of the synchronizers until the context is done. The ready for claim counts are read
+// first, so the deposits which are ready from the first notification on are sent.
+func (n *DepositNotifier) Start(ctx context.Context) {
+	if err := n.readReadyCounts(ctx); err != nil {
+		log.Error("error reading the ready for claim deposit counts. Error: ", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("deposit notifier ctx done")
+			return
+		case <-n.queued:
+			for _, notification := range n.dequeue() {
+				if err := n.process(ctx, notification); err != nil {
+					log.Errorf("networkID: %d, error sending the deposit events. Error: %s", notification.networkID, err.Error())
+				}
+			}
+		}
+	}
+}
+
+// BlockSynced is called by the synchronizers when a block is stored.
+func (n *DepositNotifier) BlockSynced(networkID uint, deposits []*etherman.Deposit, claims []*etherman.Claim, globalExitRoots []*etherman.GlobalExitRoot) {
+	if len(deposits) == 0 && len(claims) == 0 && len(globalExitRoots) == 0 {
+		return
+	}
+	n.enqueue(&notification{networkID: networkID, deposits: deposits, claims: claims, globalExitRoots: globalExitRoots})
+}
+
+// TrustedExitRootSynced is called by the synchronizer of the main network when the trusted exit root is stored.
+func (n *DepositNotifier) TrustedExitRootSynced(globalExitRoot *etherman.GlobalExitRoot) {
+	n.enqueue(&notification{networkID: MainNetworkID, trusted: true})
+}
+
+// Reorged is called by the synchronizers when the blocks of a network are removed by a reorg.
+func (n *DepositNotifier) Reorged(networkID uint, removed []*etherman.Deposit) {
+	n.enqueue(&notification{networkID: networkID, deposits: removed, reorg: true})
+}
+
+// enqueue adds the notification to the queue without blocking.
+func (n *DepositNotifier) enqueue(notification *notification) {
+	n.queueLock.Lock()
+	n.queue = append(n.queue, notification)
+	n.queueLock.Unlock()
+	select {
+	case n.queued <- struct{}{}:
+	default:
+	}
+}
+
+// dequeue removes all the queued notifications.
+func (n *DepositNotifier) dequeue() []*notification {
+	n.queueLock.Lock()
+	defer n.queueLock.Unlock()
+	notifications := n.queue
+	n.queue = nil
+	return notifications
+}
+
+func (n *DepositNotifier) process(ctx context.Context, notification *notification) error {
+	// The counts are kept up to date without subscribers, so the new subscribers don't miss the next events
+	if n.readyCnts == nil {
+		if err := n.readReadyCounts(ctx); err != nil {
+			return err
+		}
+	}
+
+	if notification.reorg {
+		for _, deposit := range notification.deposits {
+			n.send(&DepositEvent{Type: DepositRemoved, Deposit: deposit})
+		}
+		// The reorg can remove deposits and exit roots of any network
+		return n.updateReadyCounts(ctx, n.bridgeCtrl.networks())
+	}
+
+	for _, deposit := range notification.deposits {
+		n.send(&DepositEvent{Type: DepositIndexed, Deposit: deposit})
+	}
+	for _, claim := range notification.claims {
+		if err := n.sendClaimed(ctx, claim); err != nil {
+			return err
+		}
+	}
+
+	// The mainnet deposits are claimed with the trusted exit root, and the rollup deposits with the exit roots
+	// synced from L1. The deposits of a network can be included in an exit root synced before them.
+	var networks []uint
+	switch {
+	case notification.trusted:
+		networks = []uint{MainNetworkID}
+	case len(notification.globalExitRoots) > 0:
+		for _, networkID := range n.bridgeCtrl.networks() {
+			if networkID != MainNetworkID {
+				networks = append(networks, networkID)
+			}
+		}
+	case len(notification.deposits) > 0:
+		networks = []uint{notification.networkID}
+	}
+	return n.updateReadyCounts(ctx, networks)
+}
+
+// readReadyCounts reads the number of ready for claim deposits of all the networks without sending events.
+func (n *DepositNotifier) readReadyCounts(ctx context.Context) error {
+	readyCnts := make(map[uint]uint)
+	for _, networkID := range n.bridgeCtrl.networks() {
+		readyCnt, err := n.storage.GetReadyDepositCount(ctx, networkID, nil)
+		if err != nil {
+			return err
+		}
+		readyCnts[networkID] = readyCnt
+	}
+	n.readyCnts = readyCnts
+	return nil
+}
+
+// updateReadyCounts reads the number of ready for claim deposits of the networks, and sends the events of the
+// deposits which are ready since the last update. The counts lowered by a reorg are updated without sending events.
+func (n *DepositNotifier) updateReadyCounts(ctx context.Context, networks []uint) error {
+	for _, networkID := range networks {
+		readyCnt, err := n.storage.GetReadyDepositCount(ctx, networkID, nil)
+		if err != nil {
+			return err
+		}
+		prevCnt := n.readyCnts[networkID]
+		if readyCnt <= prevCnt {
+			n.readyCnts[networkID] = readyCnt
+			continue
+		}
+		deposits, err := n.storage.GetNetworkDeposits(ctx, networkID, prevCnt, readyCnt-prevCnt, nil)
+		if err != nil {
+			return err
+		}
+		n.readyCnts[networkID] = readyCnt
+		for _, deposit := range deposits {
+			n.send(&DepositEvent{Type: DepositReadyForClaim, Deposit: deposit})
+		}
+	}
+	return nil
+}
+
+// sendClaimed sends the event of the deposit of the claim, which is in the source network of the claim.
+func (n *DepositNotifier) sendClaimed(ctx context.Context, claim *etherman.Claim) error {
+	deposit, err := n.storage.GetDeposit(ctx, claim.Index, claim.SourceNetwork, nil)
+	if err != nil {
+		if err == gerror.ErrStorageNotFound {
+			return nil
+		}
+		return err
+	}
+	if deposit.DestinationNetwork != claim.NetworkID {
+		return nil
+	}
+	n.send(&DepositEvent{Type: DepositClaimed, Deposit: deposit, ClaimTxHash: claim.TxHash})
+	return nil
+}
+
+// send sends the event to the matching subscriptions. The subscriptions whose buffer is full are closed.
+func (n *DepositNotifier) send(event *DepositEvent) {
+	n.lock.Lock()
+	var slow []*DepositSubscription
+	for sub := range n.subscriptions {
+		if !sub.filter.match(event.Deposit) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	n.lock.Unlock()
+	for _, sub := range slow {
+		n.remove(sub, gerror.ErrSlowSubscriber)
+	}
+}
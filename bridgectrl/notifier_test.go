@@ -0,0 +1,59 @@
+package bridgectrl
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepositNotifierSend(t *testing.T) {
+	n := &DepositNotifier{subscriptions: make(map[*DepositSubscription]struct{})}
+	addr := common.HexToAddress("0x01")
+	byAddr := n.Subscribe(DepositSubscriptionFilter{DestinationAddress: &addr})
+	byKey := n.Subscribe(DepositSubscriptionFilter{Deposit: &DepositKey{NetworkID: 1, DepositCount: 2}})
+
+	deposit := &etherman.Deposit{NetworkID: 1, DepositCount: 2, DestinationAddress: addr}
+	n.send(&DepositEvent{Type: DepositIndexed, Deposit: deposit})
+	n.send(&DepositEvent{Type: DepositIndexed, Deposit: &etherman.Deposit{NetworkID: 0, DepositCount: 2, DestinationAddress: addr}})
+	require.Len(t, byAddr.Events(), 2)
+	require.Len(t, byKey.Events(), 1)
+	event := <-byKey.Events()
+	require.Equal(t, deposit, event.Deposit)
+
+	// The subscriptions which don't keep up are closed
+	for i := 0; i < subscriptionBufferSize; i++ {
+		n.send(&DepositEvent{Type: DepositIndexed, Deposit: deposit})
+	}
+	for range byAddr.Events() {
+	}
+	require.Equal(t, gerror.ErrSlowSubscriber, byAddr.Err())
+	require.Len(t, byKey.Events(), subscriptionBufferSize)
+
+	byKey.Unsubscribe()
+	for range byKey.Events() {
+	}
+	require.NoError(t, byKey.Err())
+	require.Empty(t, n.subscriptions)
+}
+
+func TestDepositNotifierQueue(t *testing.T) {
+	n := &DepositNotifier{queued: make(chan struct{}, 1)}
+	// The synchronizers aren't blocked while the notifier isn't processing the notifications
+	for i := 0; i < 5000; i++ {
+		n.BlockSynced(uint(i), []*etherman.Deposit{{}}, nil, nil)
+	}
+	n.BlockSynced(1, nil, nil, nil)
+	n.Reorged(1, nil)
+	require.Len(t, n.queued, 1)
+
+	notifications := n.dequeue()
+	require.Len(t, notifications, 5001)
+	for i := 0; i < 5000; i++ {
+		require.Equal(t, uint(i), notifications[i].networkID)
+	}
+	require.True(t, notifications[5000].reorg)
+	require.Empty(t, n.dequeue())
+}
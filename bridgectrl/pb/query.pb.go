@@ -24,6 +24,258 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Reason why a merkle proof doesn't match
+type ProofMismatchReason int32
+
+const (
+	ProofMismatchReason_PROOF_MISMATCH_REASON_NONE ProofMismatchReason = 0
+	// The number of siblings is not the height of the tree
+	ProofMismatchReason_PROOF_MISMATCH_REASON_INVALID_LENGTH ProofMismatchReason = 1
+	// The root computed from the deposit leaf and the siblings is not the supplied root
+	ProofMismatchReason_PROOF_MISMATCH_REASON_ROOT_MISMATCH ProofMismatchReason = 2
+	// The supplied root is not a root of the deposit network exit tree
+	ProofMismatchReason_PROOF_MISMATCH_REASON_UNKNOWN_ROOT ProofMismatchReason = 3
+	// The supplied exit roots are not of a synced global exit root
+	ProofMismatchReason_PROOF_MISMATCH_REASON_UNKNOWN_EXIT_ROOT ProofMismatchReason = 4
+	// The exit root computed from the root, with the rollup proof for the rollups, is not the supplied one of the network
+	ProofMismatchReason_PROOF_MISMATCH_REASON_EXIT_ROOT_MISMATCH ProofMismatchReason = 5
+)
+
+// Enum value maps for ProofMismatchReason.
+var (
+	ProofMismatchReason_name = map[int32]string{
+		0: "PROOF_MISMATCH_REASON_NONE",
+		1: "PROOF_MISMATCH_REASON_INVALID_LENGTH",
+		2: "PROOF_MISMATCH_REASON_ROOT_MISMATCH",
+		3: "PROOF_MISMATCH_REASON_UNKNOWN_ROOT",
+		4: "PROOF_MISMATCH_REASON_UNKNOWN_EXIT_ROOT",
+		5: "PROOF_MISMATCH_REASON_EXIT_ROOT_MISMATCH",
+	}
+	ProofMismatchReason_value = map[string]int32{
+		"PROOF_MISMATCH_REASON_NONE":               0,
+		"PROOF_MISMATCH_REASON_INVALID_LENGTH":     1,
+		"PROOF_MISMATCH_REASON_ROOT_MISMATCH":      2,
+		"PROOF_MISMATCH_REASON_UNKNOWN_ROOT":       3,
+		"PROOF_MISMATCH_REASON_UNKNOWN_EXIT_ROOT":  4,
+		"PROOF_MISMATCH_REASON_EXIT_ROOT_MISMATCH": 5,
+	}
+)
+
+func (x ProofMismatchReason) Enum() *ProofMismatchReason {
+	p := new(ProofMismatchReason)
+	*p = x
+	return p
+}
+
+func (x ProofMismatchReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProofMismatchReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_query_proto_enumTypes[0].Descriptor()
+}
+
+func (ProofMismatchReason) Type() protoreflect.EnumType {
+	return &file_query_proto_enumTypes[0]
+}
+
+func (x ProofMismatchReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProofMismatchReason.Descriptor instead.
+func (ProofMismatchReason) EnumDescriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{0}
+}
+
+// Claim status of a deposit
+type DepositStatus int32
+
+const (
+	DepositStatus_DEPOSIT_STATUS_UNSPECIFIED DepositStatus = 0
+	// The deposit is not included yet in the exit root used to claim it
+	DepositStatus_DEPOSIT_STATUS_PENDING DepositStatus = 1
+	// The deposit can be claimed in the destination network
+	DepositStatus_DEPOSIT_STATUS_READY_FOR_CLAIM DepositStatus = 2
+	// The deposit is claimed in the destination network
+	DepositStatus_DEPOSIT_STATUS_CLAIMED DepositStatus = 3
+)
+
+// Enum value maps for DepositStatus.
+var (
+	DepositStatus_name = map[int32]string{
+		0: "DEPOSIT_STATUS_UNSPECIFIED",
+		1: "DEPOSIT_STATUS_PENDING",
+		2: "DEPOSIT_STATUS_READY_FOR_CLAIM",
+		3: "DEPOSIT_STATUS_CLAIMED",
+	}
+	DepositStatus_value = map[string]int32{
+		"DEPOSIT_STATUS_UNSPECIFIED":     0,
+		"DEPOSIT_STATUS_PENDING":         1,
+		"DEPOSIT_STATUS_READY_FOR_CLAIM": 2,
+		"DEPOSIT_STATUS_CLAIMED":         3,
+	}
+)
+
+func (x DepositStatus) Enum() *DepositStatus {
+	p := new(DepositStatus)
+	*p = x
+	return p
+}
+
+func (x DepositStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_query_proto_enumTypes[1].Descriptor()
+}
+
+func (DepositStatus) Type() protoreflect.EnumType {
+	return &file_query_proto_enumTypes[1]
+}
+
+func (x DepositStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositStatus.Descriptor instead.
+func (DepositStatus) EnumDescriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{1}
+}
+
+// State of the lifecycle of a deposit
+type DepositState int32
+
+const (
+	DepositState_DEPOSIT_STATE_UNSPECIFIED DepositState = 0
+	// The deposit is synced
+	DepositState_DEPOSIT_STATE_INDEXED DepositState = 1
+	// The deposit is included in the local exit root of its network
+	DepositState_DEPOSIT_STATE_INCLUDED DepositState = 2
+	// The deposit is included in a global exit root synced from L1
+	DepositState_DEPOSIT_STATE_EXIT_ROOT_ON_L1 DepositState = 3
+	// The rollup deposit is included in a verified batch
+	DepositState_DEPOSIT_STATE_BATCH_VERIFIED DepositState = 4
+	// The deposit is included in the exit root used to claim it
+	DepositState_DEPOSIT_STATE_CLAIMABLE DepositState = 5
+	// The deposit is claimed in the destination network
+	DepositState_DEPOSIT_STATE_CLAIMED DepositState = 6
+	// The deposit is removed by a reorg
+	DepositState_DEPOSIT_STATE_REORGED DepositState = 7
+)
+
+// Enum value maps for DepositState.
+var (
+	DepositState_name = map[int32]string{
+		0: "DEPOSIT_STATE_UNSPECIFIED",
+		1: "DEPOSIT_STATE_INDEXED",
+		2: "DEPOSIT_STATE_INCLUDED",
+		3: "DEPOSIT_STATE_EXIT_ROOT_ON_L1",
+		4: "DEPOSIT_STATE_BATCH_VERIFIED",
+		5: "DEPOSIT_STATE_CLAIMABLE",
+		6: "DEPOSIT_STATE_CLAIMED",
+		7: "DEPOSIT_STATE_REORGED",
+	}
+	DepositState_value = map[string]int32{
+		"DEPOSIT_STATE_UNSPECIFIED":     0,
+		"DEPOSIT_STATE_INDEXED":         1,
+		"DEPOSIT_STATE_INCLUDED":        2,
+		"DEPOSIT_STATE_EXIT_ROOT_ON_L1": 3,
+		"DEPOSIT_STATE_BATCH_VERIFIED":  4,
+		"DEPOSIT_STATE_CLAIMABLE":       5,
+		"DEPOSIT_STATE_CLAIMED":         6,
+		"DEPOSIT_STATE_REORGED":         7,
+	}
+)
+
+func (x DepositState) Enum() *DepositState {
+	p := new(DepositState)
+	*p = x
+	return p
+}
+
+func (x DepositState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositState) Descriptor() protoreflect.EnumDescriptor {
+	return file_query_proto_enumTypes[2].Descriptor()
+}
+
+func (DepositState) Type() protoreflect.EnumType {
+	return &file_query_proto_enumTypes[2]
+}
+
+func (x DepositState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositState.Descriptor instead.
+func (DepositState) EnumDescriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{2}
+}
+
+// Kind of change of the status of a deposit
+type DepositEventType int32
+
+const (
+	DepositEventType_DEPOSIT_EVENT_TYPE_UNSPECIFIED DepositEventType = 0
+	// The deposit is synced
+	DepositEventType_DEPOSIT_EVENT_TYPE_INDEXED DepositEventType = 1
+	// The deposit can be claimed in the destination network
+	DepositEventType_DEPOSIT_EVENT_TYPE_READY_FOR_CLAIM DepositEventType = 2
+	// The claim of the deposit is synced
+	DepositEventType_DEPOSIT_EVENT_TYPE_CLAIMED DepositEventType = 3
+	// The deposit is removed by a reorg
+	DepositEventType_DEPOSIT_EVENT_TYPE_REMOVED DepositEventType = 4
+)
+
+// Enum value maps for DepositEventType.
+var (
+	DepositEventType_name = map[int32]string{
+		0: "DEPOSIT_EVENT_TYPE_UNSPECIFIED",
+		1: "DEPOSIT_EVENT_TYPE_INDEXED",
+		2: "DEPOSIT_EVENT_TYPE_READY_FOR_CLAIM",
+		3: "DEPOSIT_EVENT_TYPE_CLAIMED",
+		4: "DEPOSIT_EVENT_TYPE_REMOVED",
+	}
+	DepositEventType_value = map[string]int32{
+		"DEPOSIT_EVENT_TYPE_UNSPECIFIED":     0,
+		"DEPOSIT_EVENT_TYPE_INDEXED":         1,
+		"DEPOSIT_EVENT_TYPE_READY_FOR_CLAIM": 2,
+		"DEPOSIT_EVENT_TYPE_CLAIMED":         3,
+		"DEPOSIT_EVENT_TYPE_REMOVED":         4,
+	}
+)
+
+func (x DepositEventType) Enum() *DepositEventType {
+	p := new(DepositEventType)
+	*p = x
+	return p
+}
+
+func (x DepositEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DepositEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_query_proto_enumTypes[3].Descriptor()
+}
+
+func (DepositEventType) Type() protoreflect.EnumType {
+	return &file_query_proto_enumTypes[3]
+}
+
+func (x DepositEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DepositEventType.Descriptor instead.
+func (DepositEventType) EnumDescriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{3}
+}
+
 // TokenWrapped message
 type TokenWrapped struct {
 	state         protoimpl.MessageState
@@ -139,6 +391,10 @@ type Deposit struct {
 	ClaimTxHash   string `protobuf:"bytes,11,opt,name=claim_tx_hash,json=claimTxHash,proto3" json:"claim_tx_hash,omitempty"`
 	Metadata      string `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	ReadyForClaim bool   `protobuf:"varint,13,opt,name=ready_for_claim,json=readyForClaim,proto3" json:"ready_for_claim,omitempty"`
+	// Sender of the bridge transaction, the zero address if it isn't known yet
+	SenderAddr string `protobuf:"bytes,14,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// Estimated time to be claimable, only set for the pending deposits of networks with enough recent deposits
+	Eta *DepositEta `protobuf:"bytes,15,opt,name=eta,proto3" json:"eta,omitempty"`
 }
 
 func (x *Deposit) Reset() {
@@ -264,6 +520,94 @@ func (x *Deposit) GetReadyForClaim() bool {
 	return false
 }
 
+func (x *Deposit) GetSenderAddr() string {
+	if x != nil {
+		return x.SenderAddr
+	}
+	return ""
+}
+
+func (x *Deposit) GetEta() *DepositEta {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+// Estimated time a pending deposit will be claimable in unix seconds, learned from the recent deposits of its network.
+// The range is the 10th to the 90th percentile of their latencies, the times already passed are now.
+type DepositEta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EstimatedTime int64 `protobuf:"varint,1,opt,name=estimated_time,json=estimatedTime,proto3" json:"estimated_time,omitempty"`
+	EarliestTime  int64 `protobuf:"varint,2,opt,name=earliest_time,json=earliestTime,proto3" json:"earliest_time,omitempty"`
+	LatestTime    int64 `protobuf:"varint,3,opt,name=latest_time,json=latestTime,proto3" json:"latest_time,omitempty"`
+	// Number of recent deposits the estimation is computed from
+	Samples uint64 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *DepositEta) Reset() {
+	*x = DepositEta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositEta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositEta) ProtoMessage() {}
+
+func (x *DepositEta) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositEta.ProtoReflect.Descriptor instead.
+func (*DepositEta) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DepositEta) GetEstimatedTime() int64 {
+	if x != nil {
+		return x.EstimatedTime
+	}
+	return 0
+}
+
+func (x *DepositEta) GetEarliestTime() int64 {
+	if x != nil {
+		return x.EarliestTime
+	}
+	return 0
+}
+
+func (x *DepositEta) GetLatestTime() int64 {
+	if x != nil {
+		return x.LatestTime
+	}
+	return 0
+}
+
+func (x *DepositEta) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
 // Claim message
 type Claim struct {
 	state         protoimpl.MessageState
@@ -278,12 +622,13 @@ type Claim struct {
 	DestAddr  string `protobuf:"bytes,6,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
 	BlockNum  uint64 `protobuf:"varint,7,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
 	TxHash    string `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	SourceNet uint32 `protobuf:"varint,9,opt,name=source_net,json=sourceNet,proto3" json:"source_net,omitempty"`
 }
 
 func (x *Claim) Reset() {
 	*x = Claim{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[2]
+		mi := &file_query_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -296,7 +641,7 @@ func (x *Claim) String() string {
 func (*Claim) ProtoMessage() {}
 
 func (x *Claim) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[2]
+	mi := &file_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +654,7 @@ func (x *Claim) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Claim.ProtoReflect.Descriptor instead.
 func (*Claim) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{2}
+	return file_query_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Claim) GetIndex() uint64 {
@@ -368,34 +713,44 @@ func (x *Claim) GetTxHash() string {
 	return ""
 }
 
-// Merkle Proof message
-type Proof struct {
+func (x *Claim) GetSourceNet() uint32 {
+	if x != nil {
+		return x.SourceNet
+	}
+	return 0
+}
+
+// TokenVolume message, the number and the amount of the deposits and the claims of a token
+type TokenVolume struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MerkleProof    []string `protobuf:"bytes,1,rep,name=merkle_proof,json=merkleProof,proto3" json:"merkle_proof,omitempty"`
-	MainExitRoot   string   `protobuf:"bytes,2,opt,name=main_exit_root,json=mainExitRoot,proto3" json:"main_exit_root,omitempty"`
-	RollupExitRoot string   `protobuf:"bytes,3,opt,name=rollup_exit_root,json=rollupExitRoot,proto3" json:"rollup_exit_root,omitempty"`
+	// Window in seconds, zero for the volume of all the synced blocks
+	Window        uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	DepositCnt    uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	DepositAmount string `protobuf:"bytes,3,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount,omitempty"`
+	ClaimCnt      uint64 `protobuf:"varint,4,opt,name=claim_cnt,json=claimCnt,proto3" json:"claim_cnt,omitempty"`
+	ClaimAmount   string `protobuf:"bytes,5,opt,name=claim_amount,json=claimAmount,proto3" json:"claim_amount,omitempty"`
 }
 
-func (x *Proof) Reset() {
-	*x = Proof{}
+func (x *TokenVolume) Reset() {
+	*x = TokenVolume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[3]
+		mi := &file_query_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Proof) String() string {
+func (x *TokenVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Proof) ProtoMessage() {}
+func (*TokenVolume) ProtoMessage() {}
 
-func (x *Proof) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[3]
+func (x *TokenVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,82 +761,65 @@ func (x *Proof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Proof.ProtoReflect.Descriptor instead.
-func (*Proof) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use TokenVolume.ProtoReflect.Descriptor instead.
+func (*TokenVolume) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Proof) GetMerkleProof() []string {
+func (x *TokenVolume) GetWindow() uint64 {
 	if x != nil {
-		return x.MerkleProof
+		return x.Window
 	}
-	return nil
+	return 0
 }
 
-func (x *Proof) GetMainExitRoot() string {
+func (x *TokenVolume) GetDepositCnt() uint64 {
 	if x != nil {
-		return x.MainExitRoot
+		return x.DepositCnt
 	}
-	return ""
+	return 0
 }
 
-func (x *Proof) GetRollupExitRoot() string {
+func (x *TokenVolume) GetDepositAmount() string {
 	if x != nil {
-		return x.RollupExitRoot
+		return x.DepositAmount
 	}
 	return ""
 }
 
-type CheckAPIRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *CheckAPIRequest) Reset() {
-	*x = CheckAPIRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TokenVolume) GetClaimCnt() uint64 {
+	if x != nil {
+		return x.ClaimCnt
 	}
+	return 0
 }
 
-func (x *CheckAPIRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CheckAPIRequest) ProtoMessage() {}
-
-func (x *CheckAPIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TokenVolume) GetClaimAmount() string {
+	if x != nil {
+		return x.ClaimAmount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CheckAPIRequest.ProtoReflect.Descriptor instead.
-func (*CheckAPIRequest) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{4}
+	return ""
 }
 
-type GetBridgesRequest struct {
+// TokenStats message, the statistics of a token identified by its original network and address in a network
+type TokenStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
-	Offset   uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	NetworkId     uint32         `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	OrigNet       uint32         `protobuf:"varint,2,opt,name=orig_net,json=origNet,proto3" json:"orig_net,omitempty"`
+	OrigTokenAddr string         `protobuf:"bytes,3,opt,name=orig_token_addr,json=origTokenAddr,proto3" json:"orig_token_addr,omitempty"`
+	Total         *TokenVolume   `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Windows       []*TokenVolume `protobuf:"bytes,5,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Amount of the deposits of the network not claimed yet in their destination network
+	OutstandingAmount string `protobuf:"bytes,6,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"`
+	// Amount held by the bridge of the network, zero for the tokens originated in other networks
+	LockedAmount string `protobuf:"bytes,7,opt,name=locked_amount,json=lockedAmount,proto3" json:"locked_amount,omitempty"`
 }
 
-func (x *GetBridgesRequest) Reset() {
-	*x = GetBridgesRequest{}
+func (x *TokenStats) Reset() {
+	*x = TokenStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -489,13 +827,13 @@ func (x *GetBridgesRequest) Reset() {
 	}
 }
 
-func (x *GetBridgesRequest) String() string {
+func (x *TokenStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgesRequest) ProtoMessage() {}
+func (*TokenStats) ProtoMessage() {}
 
-func (x *GetBridgesRequest) ProtoReflect() protoreflect.Message {
+func (x *TokenStats) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -507,43 +845,77 @@ func (x *GetBridgesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBridgesRequest.ProtoReflect.Descriptor instead.
-func (*GetBridgesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenStats.ProtoReflect.Descriptor instead.
+func (*TokenStats) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetBridgesRequest) GetDestAddr() string {
+func (x *TokenStats) GetNetworkId() uint32 {
 	if x != nil {
-		return x.DestAddr
+		return x.NetworkId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetBridgesRequest) GetOffset() uint64 {
+func (x *TokenStats) GetOrigNet() uint32 {
 	if x != nil {
-		return x.Offset
+		return x.OrigNet
 	}
 	return 0
 }
 
-func (x *GetBridgesRequest) GetLimit() uint32 {
+func (x *TokenStats) GetOrigTokenAddr() string {
 	if x != nil {
-		return x.Limit
+		return x.OrigTokenAddr
 	}
-	return 0
+	return ""
 }
 
-type GetProofRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
-	DepositCnt uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+func (x *TokenStats) GetTotal() *TokenVolume {
+	if x != nil {
+		return x.Total
+	}
+	return nil
 }
 
-func (x *GetProofRequest) Reset() {
-	*x = GetProofRequest{}
+func (x *TokenStats) GetWindows() []*TokenVolume {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *TokenStats) GetOutstandingAmount() string {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return ""
+}
+
+func (x *TokenStats) GetLockedAmount() string {
+	if x != nil {
+		return x.LockedAmount
+	}
+	return ""
+}
+
+// Merkle Proof message
+type Proof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MerkleProof    []string `protobuf:"bytes,1,rep,name=merkle_proof,json=merkleProof,proto3" json:"merkle_proof,omitempty"`
+	MainExitRoot   string   `protobuf:"bytes,2,opt,name=main_exit_root,json=mainExitRoot,proto3" json:"main_exit_root,omitempty"`
+	RollupExitRoot string   `protobuf:"bytes,3,opt,name=rollup_exit_root,json=rollupExitRoot,proto3" json:"rollup_exit_root,omitempty"`
+	// Proof of the local exit root of the rollup against the rollup exit root, empty for the mainnet deposits
+	RollupMerkleProof []string `protobuf:"bytes,4,rep,name=rollup_merkle_proof,json=rollupMerkleProof,proto3" json:"rollup_merkle_proof,omitempty"`
+	// Index of the local exit root of the rollup in the rollup exit tree
+	RollupIndex uint32 `protobuf:"varint,5,opt,name=rollup_index,json=rollupIndex,proto3" json:"rollup_index,omitempty"`
+}
+
+func (x *Proof) Reset() {
+	*x = Proof{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,13 +923,13 @@ func (x *GetProofRequest) Reset() {
 	}
 }
 
-func (x *GetProofRequest) String() string {
+func (x *Proof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProofRequest) ProtoMessage() {}
+func (*Proof) ProtoMessage() {}
 
-func (x *GetProofRequest) ProtoReflect() protoreflect.Message {
+func (x *Proof) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -569,36 +941,64 @@ func (x *GetProofRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProofRequest.ProtoReflect.Descriptor instead.
-func (*GetProofRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Proof.ProtoReflect.Descriptor instead.
+func (*Proof) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetProofRequest) GetNetId() uint32 {
+func (x *Proof) GetMerkleProof() []string {
 	if x != nil {
-		return x.NetId
+		return x.MerkleProof
 	}
-	return 0
+	return nil
 }
 
-func (x *GetProofRequest) GetDepositCnt() uint64 {
+func (x *Proof) GetMainExitRoot() string {
 	if x != nil {
-		return x.DepositCnt
+		return x.MainExitRoot
+	}
+	return ""
+}
+
+func (x *Proof) GetRollupExitRoot() string {
+	if x != nil {
+		return x.RollupExitRoot
+	}
+	return ""
+}
+
+func (x *Proof) GetRollupMerkleProof() []string {
+	if x != nil {
+		return x.RollupMerkleProof
+	}
+	return nil
+}
+
+func (x *Proof) GetRollupIndex() uint32 {
+	if x != nil {
+		return x.RollupIndex
 	}
 	return 0
 }
 
-type GetTokenWrappedRequest struct {
+// Change of the state of a deposit
+type DepositTransition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrigTokenAddr string `protobuf:"bytes,1,opt,name=orig_token_addr,json=origTokenAddr,proto3" json:"orig_token_addr,omitempty"`
-	OrigNet       uint32 `protobuf:"varint,2,opt,name=orig_net,json=origNet,proto3" json:"orig_net,omitempty"`
+	State DepositState `protobuf:"varint,1,opt,name=state,proto3,enum=bridge.v1.DepositState" json:"state,omitempty"`
+	// Network and number of the block where the transition was synced, zero for the transitions synced from the
+	// trusted state and the reorgs
+	NetworkId uint32 `protobuf:"varint,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	BlockNum  uint64 `protobuf:"varint,3,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	// Time of the block in unix seconds, or the time the transition was observed for the transitions without a block.
+	// Zero when it's unknown
+	Time int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
 }
 
-func (x *GetTokenWrappedRequest) Reset() {
-	*x = GetTokenWrappedRequest{}
+func (x *DepositTransition) Reset() {
+	*x = DepositTransition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,13 +1006,13 @@ func (x *GetTokenWrappedRequest) Reset() {
 	}
 }
 
-func (x *GetTokenWrappedRequest) String() string {
+func (x *DepositTransition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenWrappedRequest) ProtoMessage() {}
+func (*DepositTransition) ProtoMessage() {}
 
-func (x *GetTokenWrappedRequest) ProtoReflect() protoreflect.Message {
+func (x *DepositTransition) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -624,36 +1024,47 @@ func (x *GetTokenWrappedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenWrappedRequest.ProtoReflect.Descriptor instead.
-func (*GetTokenWrappedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DepositTransition.ProtoReflect.Descriptor instead.
+func (*DepositTransition) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetTokenWrappedRequest) GetOrigTokenAddr() string {
+func (x *DepositTransition) GetState() DepositState {
 	if x != nil {
-		return x.OrigTokenAddr
+		return x.State
 	}
-	return ""
+	return DepositState_DEPOSIT_STATE_UNSPECIFIED
 }
 
-func (x *GetTokenWrappedRequest) GetOrigNet() uint32 {
+func (x *DepositTransition) GetNetworkId() uint32 {
 	if x != nil {
-		return x.OrigNet
+		return x.NetworkId
 	}
 	return 0
 }
 
-type GetBridgeRequest struct {
+func (x *DepositTransition) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *DepositTransition) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type CheckAPIRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
-	DepositCnt uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
 }
 
-func (x *GetBridgeRequest) Reset() {
-	*x = GetBridgeRequest{}
+func (x *CheckAPIRequest) Reset() {
+	*x = CheckAPIRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -661,13 +1072,13 @@ func (x *GetBridgeRequest) Reset() {
 	}
 }
 
-func (x *GetBridgeRequest) String() string {
+func (x *CheckAPIRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgeRequest) ProtoMessage() {}
+func (*CheckAPIRequest) ProtoMessage() {}
 
-func (x *GetBridgeRequest) ProtoReflect() protoreflect.Message {
+func (x *CheckAPIRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -679,37 +1090,40 @@ func (x *GetBridgeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBridgeRequest.ProtoReflect.Descriptor instead.
-func (*GetBridgeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckAPIRequest.ProtoReflect.Descriptor instead.
+func (*CheckAPIRequest) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetBridgeRequest) GetNetId() uint32 {
-	if x != nil {
-		return x.NetId
-	}
-	return 0
-}
-
-func (x *GetBridgeRequest) GetDepositCnt() uint64 {
-	if x != nil {
-		return x.DepositCnt
-	}
-	return 0
-}
-
-type GetClaimsRequest struct {
+type GetBridgesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The filters are optional, the deposits must match all the provided ones
 	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
 	Offset   uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Network where the deposit was done
+	NetId   *uint32 `protobuf:"varint,4,opt,name=net_id,json=netId,proto3,oneof" json:"net_id,omitempty"`
+	DestNet *uint32 `protobuf:"varint,5,opt,name=dest_net,json=destNet,proto3,oneof" json:"dest_net,omitempty"`
+	// Token of the deposit, identified by its original network and address
+	OrigNet  *uint32       `protobuf:"varint,6,opt,name=orig_net,json=origNet,proto3,oneof" json:"orig_net,omitempty"`
+	OrigAddr string        `protobuf:"bytes,7,opt,name=orig_addr,json=origAddr,proto3" json:"orig_addr,omitempty"`
+	LeafType *uint32       `protobuf:"varint,8,opt,name=leaf_type,json=leafType,proto3,oneof" json:"leaf_type,omitempty"`
+	Status   DepositStatus `protobuf:"varint,9,opt,name=status,proto3,enum=bridge.v1.DepositStatus" json:"status,omitempty"`
+	// Range of block numbers of the network of the deposit, both included
+	FromBlock *uint64 `protobuf:"varint,10,opt,name=from_block,json=fromBlock,proto3,oneof" json:"from_block,omitempty"`
+	ToBlock   *uint64 `protobuf:"varint,11,opt,name=to_block,json=toBlock,proto3,oneof" json:"to_block,omitempty"`
+	// Range of block timestamps in unix seconds, both included
+	FromTime *int64 `protobuf:"varint,12,opt,name=from_time,json=fromTime,proto3,oneof" json:"from_time,omitempty"`
+	ToTime   *int64 `protobuf:"varint,13,opt,name=to_time,json=toTime,proto3,oneof" json:"to_time,omitempty"`
+	// Cursor returned in the next_cursor of the previous page, the offset is applied after it
+	Cursor string `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetClaimsRequest) Reset() {
-	*x = GetClaimsRequest{}
+func (x *GetBridgesRequest) Reset() {
+	*x = GetBridgesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -717,13 +1131,13 @@ func (x *GetClaimsRequest) Reset() {
 	}
 }
 
-func (x *GetClaimsRequest) String() string {
+func (x *GetBridgesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClaimsRequest) ProtoMessage() {}
+func (*GetBridgesRequest) ProtoMessage() {}
 
-func (x *GetClaimsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBridgesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,105 +1149,137 @@ func (x *GetClaimsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClaimsRequest.ProtoReflect.Descriptor instead.
-func (*GetClaimsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBridgesRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgesRequest) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetClaimsRequest) GetDestAddr() string {
+func (x *GetBridgesRequest) GetDestAddr() string {
 	if x != nil {
 		return x.DestAddr
 	}
 	return ""
 }
 
-func (x *GetClaimsRequest) GetOffset() uint64 {
+func (x *GetBridgesRequest) GetOffset() uint64 {
 	if x != nil {
 		return x.Offset
 	}
 	return 0
 }
 
-func (x *GetClaimsRequest) GetLimit() uint32 {
+func (x *GetBridgesRequest) GetLimit() uint32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type CheckAPIResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetBridgesRequest) GetNetId() uint32 {
+	if x != nil && x.NetId != nil {
+		return *x.NetId
+	}
+	return 0
+}
 
-	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+func (x *GetBridgesRequest) GetDestNet() uint32 {
+	if x != nil && x.DestNet != nil {
+		return *x.DestNet
+	}
+	return 0
 }
 
-func (x *CheckAPIResponse) Reset() {
-	*x = CheckAPIResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetBridgesRequest) GetOrigNet() uint32 {
+	if x != nil && x.OrigNet != nil {
+		return *x.OrigNet
 	}
+	return 0
 }
 
-func (x *CheckAPIResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetBridgesRequest) GetOrigAddr() string {
+	if x != nil {
+		return x.OrigAddr
+	}
+	return ""
 }
 
-func (*CheckAPIResponse) ProtoMessage() {}
+func (x *GetBridgesRequest) GetLeafType() uint32 {
+	if x != nil && x.LeafType != nil {
+		return *x.LeafType
+	}
+	return 0
+}
 
-func (x *CheckAPIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetBridgesRequest) GetStatus() DepositStatus {
+	if x != nil {
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return DepositStatus_DEPOSIT_STATUS_UNSPECIFIED
 }
 
-// Deprecated: Use CheckAPIResponse.ProtoReflect.Descriptor instead.
-func (*CheckAPIResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{10}
+func (x *GetBridgesRequest) GetFromBlock() uint64 {
+	if x != nil && x.FromBlock != nil {
+		return *x.FromBlock
+	}
+	return 0
 }
 
-func (x *CheckAPIResponse) GetApi() string {
+func (x *GetBridgesRequest) GetToBlock() uint64 {
+	if x != nil && x.ToBlock != nil {
+		return *x.ToBlock
+	}
+	return 0
+}
+
+func (x *GetBridgesRequest) GetFromTime() int64 {
+	if x != nil && x.FromTime != nil {
+		return *x.FromTime
+	}
+	return 0
+}
+
+func (x *GetBridgesRequest) GetToTime() int64 {
+	if x != nil && x.ToTime != nil {
+		return *x.ToTime
+	}
+	return 0
+}
+
+func (x *GetBridgesRequest) GetCursor() string {
 	if x != nil {
-		return x.Api
+		return x.Cursor
 	}
 	return ""
 }
 
-type GetBridgesResponse struct {
+type SubscribeDepositStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
-	TotalCnt uint64     `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
+	// Either the destination address or the network and the deposit count are required
+	DestAddr   string  `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	NetId      *uint32 `protobuf:"varint,2,opt,name=net_id,json=netId,proto3,oneof" json:"net_id,omitempty"`
+	DepositCnt *uint64 `protobuf:"varint,3,opt,name=deposit_cnt,json=depositCnt,proto3,oneof" json:"deposit_cnt,omitempty"`
 }
 
-func (x *GetBridgesResponse) Reset() {
-	*x = GetBridgesResponse{}
+func (x *SubscribeDepositStatusRequest) Reset() {
+	*x = SubscribeDepositStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[11]
+		mi := &file_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBridgesResponse) String() string {
+func (x *SubscribeDepositStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgesResponse) ProtoMessage() {}
+func (*SubscribeDepositStatusRequest) ProtoMessage() {}
 
-func (x *GetBridgesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[11]
+func (x *SubscribeDepositStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,50 +1290,63 @@ func (x *GetBridgesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBridgesResponse.ProtoReflect.Descriptor instead.
-func (*GetBridgesResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SubscribeDepositStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeDepositStatusRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetBridgesResponse) GetDeposits() []*Deposit {
+func (x *SubscribeDepositStatusRequest) GetDestAddr() string {
 	if x != nil {
-		return x.Deposits
+		return x.DestAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBridgesResponse) GetTotalCnt() uint64 {
-	if x != nil {
-		return x.TotalCnt
+func (x *SubscribeDepositStatusRequest) GetNetId() uint32 {
+	if x != nil && x.NetId != nil {
+		return *x.NetId
 	}
 	return 0
 }
 
-type GetProofResponse struct {
+func (x *SubscribeDepositStatusRequest) GetDepositCnt() uint64 {
+	if x != nil && x.DepositCnt != nil {
+		return *x.DepositCnt
+	}
+	return 0
+}
+
+type GetProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Proof *Proof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	// Optional, the proof is generated against this global exit root instead of the latest one
+	GlobalExitRoot string `protobuf:"bytes,3,opt,name=global_exit_root,json=globalExitRoot,proto3" json:"global_exit_root,omitempty"`
+	// Optional, the proof is generated against these exit roots of a synced global exit root instead of the latest ones
+	MainnetExitRoot string `protobuf:"bytes,4,opt,name=mainnet_exit_root,json=mainnetExitRoot,proto3" json:"mainnet_exit_root,omitempty"`
+	RollupExitRoot  string `protobuf:"bytes,5,opt,name=rollup_exit_root,json=rollupExitRoot,proto3" json:"rollup_exit_root,omitempty"`
 }
 
-func (x *GetProofResponse) Reset() {
-	*x = GetProofResponse{}
+func (x *GetProofRequest) Reset() {
+	*x = GetProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[12]
+		mi := &file_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProofResponse) String() string {
+func (x *GetProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProofResponse) ProtoMessage() {}
+func (*GetProofRequest) ProtoMessage() {}
 
-func (x *GetProofResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[12]
+func (x *GetProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,28 +1357,114 @@ func (x *GetProofResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProofResponse.ProtoReflect.Descriptor instead.
-func (*GetProofResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProofRequest.ProtoReflect.Descriptor instead.
+func (*GetProofRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetProofRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetProofRequest) GetDepositCnt() uint64 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+func (x *GetProofRequest) GetGlobalExitRoot() string {
+	if x != nil {
+		return x.GlobalExitRoot
+	}
+	return ""
+}
+
+func (x *GetProofRequest) GetMainnetExitRoot() string {
+	if x != nil {
+		return x.MainnetExitRoot
+	}
+	return ""
+}
+
+func (x *GetProofRequest) GetRollupExitRoot() string {
+	if x != nil {
+		return x.RollupExitRoot
+	}
+	return ""
+}
+
+// DepositKey message
+type DepositKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+}
+
+func (x *DepositKey) Reset() {
+	*x = DepositKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositKey) ProtoMessage() {}
+
+func (x *DepositKey) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositKey.ProtoReflect.Descriptor instead.
+func (*DepositKey) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetProofResponse) GetProof() *Proof {
+func (x *DepositKey) GetNetId() uint32 {
 	if x != nil {
-		return x.Proof
+		return x.NetId
 	}
-	return nil
+	return 0
 }
 
-type GetTokenWrappedResponse struct {
+func (x *DepositKey) GetDepositCnt() uint64 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+type GetProofsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tokenwrapped *TokenWrapped `protobuf:"bytes,1,opt,name=tokenwrapped,proto3" json:"tokenwrapped,omitempty"`
+	Deposits []*DepositKey `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	// Optional, the proofs are generated against this global exit root instead of the latest one
+	GlobalExitRoot string `protobuf:"bytes,2,opt,name=global_exit_root,json=globalExitRoot,proto3" json:"global_exit_root,omitempty"`
 }
 
-func (x *GetTokenWrappedResponse) Reset() {
-	*x = GetTokenWrappedResponse{}
+func (x *GetProofsRequest) Reset() {
+	*x = GetProofsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,13 +1472,13 @@ func (x *GetTokenWrappedResponse) Reset() {
 	}
 }
 
-func (x *GetTokenWrappedResponse) String() string {
+func (x *GetProofsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenWrappedResponse) ProtoMessage() {}
+func (*GetProofsRequest) ProtoMessage() {}
 
-func (x *GetTokenWrappedResponse) ProtoReflect() protoreflect.Message {
+func (x *GetProofsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -945,28 +1490,46 @@ func (x *GetTokenWrappedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenWrappedResponse.ProtoReflect.Descriptor instead.
-func (*GetTokenWrappedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetProofsRequest.ProtoReflect.Descriptor instead.
+func (*GetProofsRequest) Descriptor() ([]byte, []int) {
 	return file_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetTokenWrappedResponse) GetTokenwrapped() *TokenWrapped {
+func (x *GetProofsRequest) GetDeposits() []*DepositKey {
 	if x != nil {
-		return x.Tokenwrapped
+		return x.Deposits
 	}
 	return nil
 }
 
-type GetBridgeResponse struct {
+func (x *GetProofsRequest) GetGlobalExitRoot() string {
+	if x != nil {
+		return x.GlobalExitRoot
+	}
+	return ""
+}
+
+type VerifyProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
-}
-
-func (x *GetBridgeResponse) Reset() {
-	*x = GetBridgeResponse{}
+	NetId       uint32   `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt  uint64   `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	MerkleProof []string `protobuf:"bytes,3,rep,name=merkle_proof,json=merkleProof,proto3" json:"merkle_proof,omitempty"`
+	// The exit root of the deposit network, optional if the exit roots are set
+	Root string `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	// Optional, the proof is verified up to these exit roots of a synced global exit root, as the claims are
+	MainnetExitRoot string `protobuf:"bytes,5,opt,name=mainnet_exit_root,json=mainnetExitRoot,proto3" json:"mainnet_exit_root,omitempty"`
+	RollupExitRoot  string `protobuf:"bytes,6,opt,name=rollup_exit_root,json=rollupExitRoot,proto3" json:"rollup_exit_root,omitempty"`
+	// Proof of the local exit root of the rollup against the rollup exit root, empty for the mainnet deposits
+	RollupMerkleProof []string `protobuf:"bytes,7,rep,name=rollup_merkle_proof,json=rollupMerkleProof,proto3" json:"rollup_merkle_proof,omitempty"`
+	// Index of the local exit root of the rollup in the rollup exit tree
+	RollupIndex uint32 `protobuf:"varint,8,opt,name=rollup_index,json=rollupIndex,proto3" json:"rollup_index,omitempty"`
+}
+
+func (x *VerifyProofRequest) Reset() {
+	*x = VerifyProofRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -974,324 +1537,4472 @@ func (x *GetBridgeResponse) Reset() {
 	}
 }
 
-func (x *GetBridgeResponse) String() string {
+func (x *VerifyProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBridgeResponse) ProtoMessage() {}
+func (*VerifyProofRequest) ProtoMessage() {}
 
-func (x *GetBridgeResponse) ProtoReflect() protoreflect.Message {
+func (x *VerifyProofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_query_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetBridgeResponse.ProtoReflect.Descriptor instead.
-func (*GetBridgeResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetBridgeResponse) GetDeposit() *Deposit {
-	if x != nil {
-		return x.Deposit
-	}
-	return nil
-}
-
-type GetClaimsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Claims   []*Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
-	TotalCnt uint64   `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
-}
-
-func (x *GetClaimsResponse) Reset() {
-	*x = GetClaimsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_query_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetClaimsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetClaimsResponse) ProtoMessage() {}
-
-func (x *GetClaimsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_query_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyProofRequest.ProtoReflect.Descriptor instead.
+func (*VerifyProofRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VerifyProofRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *VerifyProofRequest) GetDepositCnt() uint64 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+func (x *VerifyProofRequest) GetMerkleProof() []string {
+	if x != nil {
+		return x.MerkleProof
+	}
+	return nil
+}
+
+func (x *VerifyProofRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *VerifyProofRequest) GetMainnetExitRoot() string {
+	if x != nil {
+		return x.MainnetExitRoot
+	}
+	return ""
+}
+
+func (x *VerifyProofRequest) GetRollupExitRoot() string {
+	if x != nil {
+		return x.RollupExitRoot
+	}
+	return ""
+}
+
+func (x *VerifyProofRequest) GetRollupMerkleProof() []string {
+	if x != nil {
+		return x.RollupMerkleProof
+	}
+	return nil
+}
+
+func (x *VerifyProofRequest) GetRollupIndex() uint32 {
+	if x != nil {
+		return x.RollupIndex
+	}
+	return 0
+}
+
+type GetTokenWrappedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrigTokenAddr string `protobuf:"bytes,1,opt,name=orig_token_addr,json=origTokenAddr,proto3" json:"orig_token_addr,omitempty"`
+	OrigNet       uint32 `protobuf:"varint,2,opt,name=orig_net,json=origNet,proto3" json:"orig_net,omitempty"`
+	// Network where the token is wrapped, any network when it isn't set
+	DestNet *uint32 `protobuf:"varint,3,opt,name=dest_net,json=destNet,proto3,oneof" json:"dest_net,omitempty"`
+}
+
+func (x *GetTokenWrappedRequest) Reset() {
+	*x = GetTokenWrappedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenWrappedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenWrappedRequest) ProtoMessage() {}
+
+func (x *GetTokenWrappedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenWrappedRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenWrappedRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTokenWrappedRequest) GetOrigTokenAddr() string {
+	if x != nil {
+		return x.OrigTokenAddr
+	}
+	return ""
+}
+
+func (x *GetTokenWrappedRequest) GetOrigNet() uint32 {
+	if x != nil {
+		return x.OrigNet
+	}
+	return 0
+}
+
+func (x *GetTokenWrappedRequest) GetDestNet() uint32 {
+	if x != nil && x.DestNet != nil {
+		return *x.DestNet
+	}
+	return 0
+}
+
+type GetTokenWrappedByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WrappedTokenAddr string `protobuf:"bytes,1,opt,name=wrapped_token_addr,json=wrappedTokenAddr,proto3" json:"wrapped_token_addr,omitempty"`
+	NetId            uint32 `protobuf:"varint,2,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+}
+
+func (x *GetTokenWrappedByAddressRequest) Reset() {
+	*x = GetTokenWrappedByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenWrappedByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenWrappedByAddressRequest) ProtoMessage() {}
+
+func (x *GetTokenWrappedByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenWrappedByAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenWrappedByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTokenWrappedByAddressRequest) GetWrappedTokenAddr() string {
+	if x != nil {
+		return x.WrappedTokenAddr
+	}
+	return ""
+}
+
+func (x *GetTokenWrappedByAddressRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+type ListTokenWrappedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The filters are optional, the tokens must match all the provided ones
+	NetId *uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3,oneof" json:"net_id,omitempty"`
+	// Symbol of the token, case insensitive
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Offset uint64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListTokenWrappedRequest) Reset() {
+	*x = ListTokenWrappedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTokenWrappedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokenWrappedRequest) ProtoMessage() {}
+
+func (x *ListTokenWrappedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokenWrappedRequest.ProtoReflect.Descriptor instead.
+func (*ListTokenWrappedRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTokenWrappedRequest) GetNetId() uint32 {
+	if x != nil && x.NetId != nil {
+		return *x.NetId
+	}
+	return 0
+}
+
+func (x *ListTokenWrappedRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *ListTokenWrappedRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTokenWrappedRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetBridgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+}
+
+func (x *GetBridgeRequest) Reset() {
+	*x = GetBridgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgeRequest) ProtoMessage() {}
+
+func (x *GetBridgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgeRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgeRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBridgeRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetBridgeRequest) GetDepositCnt() uint64 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Windows of the volumes in seconds, rounded to hours. By default the last day, week and 30 days.
+	Windows []uint64 `protobuf:"varint,1,rep,packed,name=windows,proto3" json:"windows,omitempty"`
+	// Optional, only the statistics of the network are returned
+	NetId *uint32 `protobuf:"varint,2,opt,name=net_id,json=netId,proto3,oneof" json:"net_id,omitempty"`
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStatsRequest) GetWindows() []uint64 {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetNetId() uint32 {
+	if x != nil && x.NetId != nil {
+		return *x.NetId
+	}
+	return 0
+}
+
+type GetClaimsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestAddr string `protobuf:"bytes,1,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	Offset   uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor returned in the next_cursor of the previous page, the offset is applied after it
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetClaimsRequest) Reset() {
+	*x = GetClaimsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimsRequest) ProtoMessage() {}
+
+func (x *GetClaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimsRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimsRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetClaimsRequest) GetDestAddr() string {
+	if x != nil {
+		return x.DestAddr
+	}
+	return ""
+}
+
+func (x *GetClaimsRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetClaimsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetClaimsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type CheckAPIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+}
+
+func (x *CheckAPIResponse) Reset() {
+	*x = CheckAPIResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAPIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAPIResponse) ProtoMessage() {}
+
+func (x *CheckAPIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAPIResponse.ProtoReflect.Descriptor instead.
+func (*CheckAPIResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckAPIResponse) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+type GetBridgesBySenderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderAddr string `protobuf:"bytes,1,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	Offset     uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit      uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Cursor returned in the next_cursor of the previous page, the offset is applied after it
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetBridgesBySenderRequest) Reset() {
+	*x = GetBridgesBySenderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgesBySenderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgesBySenderRequest) ProtoMessage() {}
+
+func (x *GetBridgesBySenderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgesBySenderRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgesBySenderRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetBridgesBySenderRequest) GetSenderAddr() string {
+	if x != nil {
+		return x.SenderAddr
+	}
+	return ""
+}
+
+func (x *GetBridgesBySenderRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetBridgesBySenderRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetBridgesBySenderRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetBridgesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	TotalCnt uint64     `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
+	// Cursor of the next page, empty if this is the last page
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetBridgesResponse) Reset() {
+	*x = GetBridgesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgesResponse) ProtoMessage() {}
+
+func (x *GetBridgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgesResponse.ProtoReflect.Descriptor instead.
+func (*GetBridgesResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetBridgesResponse) GetDeposits() []*Deposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *GetBridgesResponse) GetTotalCnt() uint64 {
+	if x != nil {
+		return x.TotalCnt
+	}
+	return 0
+}
+
+func (x *GetBridgesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proof *Proof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *GetProofResponse) Reset() {
+	*x = GetProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofResponse) ProtoMessage() {}
+
+func (x *GetProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofResponse.ProtoReflect.Descriptor instead.
+func (*GetProofResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetProofResponse) GetProof() *Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// ProofResult message, the error is set if the proof of the deposit can't be generated
+type ProofResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+	Proof      *Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	Error      string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ProofResult) Reset() {
+	*x = ProofResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProofResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProofResult) ProtoMessage() {}
+
+func (x *ProofResult) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProofResult.ProtoReflect.Descriptor instead.
+func (*ProofResult) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ProofResult) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *ProofResult) GetDepositCnt() uint64 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+func (x *ProofResult) GetProof() *Proof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *ProofResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proofs []*ProofResult `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *GetProofsResponse) Reset() {
+	*x = GetProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofsResponse) ProtoMessage() {}
+
+func (x *GetProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofsResponse.ProtoReflect.Descriptor instead.
+func (*GetProofsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetProofsResponse) GetProofs() []*ProofResult {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type VerifyProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid        bool                `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Reason       ProofMismatchReason `protobuf:"varint,2,opt,name=reason,proto3,enum=bridge.v1.ProofMismatchReason" json:"reason,omitempty"`
+	Leaf         string              `protobuf:"bytes,3,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	ComputedRoot string              `protobuf:"bytes,4,opt,name=computed_root,json=computedRoot,proto3" json:"computed_root,omitempty"`
+	// The exit root of the network computed from the root, set when the exit roots are verified
+	ComputedExitRoot string `protobuf:"bytes,5,opt,name=computed_exit_root,json=computedExitRoot,proto3" json:"computed_exit_root,omitempty"`
+}
+
+func (x *VerifyProofResponse) Reset() {
+	*x = VerifyProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyProofResponse) ProtoMessage() {}
+
+func (x *VerifyProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyProofResponse.ProtoReflect.Descriptor instead.
+func (*VerifyProofResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VerifyProofResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyProofResponse) GetReason() ProofMismatchReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ProofMismatchReason_PROOF_MISMATCH_REASON_NONE
+}
+
+func (x *VerifyProofResponse) GetLeaf() string {
+	if x != nil {
+		return x.Leaf
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetComputedRoot() string {
+	if x != nil {
+		return x.ComputedRoot
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetComputedExitRoot() string {
+	if x != nil {
+		return x.ComputedExitRoot
+	}
+	return ""
+}
+
+type GetTokenWrappedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokenwrapped *TokenWrapped `protobuf:"bytes,1,opt,name=tokenwrapped,proto3" json:"tokenwrapped,omitempty"`
+}
+
+func (x *GetTokenWrappedResponse) Reset() {
+	*x = GetTokenWrappedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenWrappedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenWrappedResponse) ProtoMessage() {}
+
+func (x *GetTokenWrappedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenWrappedResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenWrappedResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTokenWrappedResponse) GetTokenwrapped() *TokenWrapped {
+	if x != nil {
+		return x.Tokenwrapped
+	}
+	return nil
+}
+
+type ListTokenWrappedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokensWrapped []*TokenWrapped `protobuf:"bytes,1,rep,name=tokens_wrapped,json=tokensWrapped,proto3" json:"tokens_wrapped,omitempty"`
+	TotalCnt      uint64          `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
+}
+
+func (x *ListTokenWrappedResponse) Reset() {
+	*x = ListTokenWrappedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTokenWrappedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokenWrappedResponse) ProtoMessage() {}
+
+func (x *ListTokenWrappedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokenWrappedResponse.ProtoReflect.Descriptor instead.
+func (*ListTokenWrappedResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListTokenWrappedResponse) GetTokensWrapped() []*TokenWrapped {
+	if x != nil {
+		return x.TokensWrapped
+	}
+	return nil
+}
+
+func (x *ListTokenWrappedResponse) GetTotalCnt() uint64 {
+	if x != nil {
+		return x.TotalCnt
+	}
+	return 0
+}
+
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*TokenStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetStatsResponse) GetStats() []*TokenStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetBridgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposit *Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *GetBridgeResponse) Reset() {
+	*x = GetBridgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgeResponse) ProtoMessage() {}
+
+func (x *GetBridgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgeResponse.ProtoReflect.Descriptor instead.
+func (*GetBridgeResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetBridgeResponse) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+type GetDepositTimelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId      uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+	DepositCnt uint64 `protobuf:"varint,2,opt,name=deposit_cnt,json=depositCnt,proto3" json:"deposit_cnt,omitempty"`
+}
+
+func (x *GetDepositTimelineRequest) Reset() {
+	*x = GetDepositTimelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDepositTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositTimelineRequest) ProtoMessage() {}
+
+func (x *GetDepositTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetDepositTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetDepositTimelineRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+func (x *GetDepositTimelineRequest) GetDepositCnt() uint64 {
+	if x != nil {
+		return x.DepositCnt
+	}
+	return 0
+}
+
+type GetDepositTimelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Current state of the deposit, reorged if there is no deposit with the count now
+	State DepositState `protobuf:"varint,1,opt,name=state,proto3,enum=bridge.v1.DepositState" json:"state,omitempty"`
+	// Transitions of the current deposit in the order they were synced
+	Transitions []*DepositTransition `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	// Histories of the deposits with the same count removed by a reorg, from the oldest one
+	Reorged []*DepositHistory `protobuf:"bytes,3,rep,name=reorged,proto3" json:"reorged,omitempty"`
+}
+
+func (x *GetDepositTimelineResponse) Reset() {
+	*x = GetDepositTimelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDepositTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepositTimelineResponse) ProtoMessage() {}
+
+func (x *GetDepositTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepositTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetDepositTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetDepositTimelineResponse) GetState() DepositState {
+	if x != nil {
+		return x.State
+	}
+	return DepositState_DEPOSIT_STATE_UNSPECIFIED
+}
+
+func (x *GetDepositTimelineResponse) GetTransitions() []*DepositTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+func (x *GetDepositTimelineResponse) GetReorged() []*DepositHistory {
+	if x != nil {
+		return x.Reorged
+	}
+	return nil
+}
+
+// DepositHistory message, the transitions of a deposit removed by a reorg in the order they were synced
+type DepositHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transitions []*DepositTransition `protobuf:"bytes,1,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *DepositHistory) Reset() {
+	*x = DepositHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositHistory) ProtoMessage() {}
+
+func (x *DepositHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositHistory.ProtoReflect.Descriptor instead.
+func (*DepositHistory) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DepositHistory) GetTransitions() []*DepositTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type GetLatencySummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetLatencySummaryRequest) Reset() {
+	*x = GetLatencySummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatencySummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencySummaryRequest) ProtoMessage() {}
+
+func (x *GetLatencySummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencySummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetLatencySummaryRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{35}
+}
+
+// NetworkLatency message, the percentiles of the time in seconds the recent deposits of the network took to be claimable
+type NetworkLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId uint32  `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Samples   uint64  `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	P10       float64 `protobuf:"fixed64,3,opt,name=p10,proto3" json:"p10,omitempty"`
+	P50       float64 `protobuf:"fixed64,4,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90       float64 `protobuf:"fixed64,5,opt,name=p90,proto3" json:"p90,omitempty"`
+}
+
+func (x *NetworkLatency) Reset() {
+	*x = NetworkLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkLatency) ProtoMessage() {}
+
+func (x *NetworkLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkLatency.ProtoReflect.Descriptor instead.
+func (*NetworkLatency) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NetworkLatency) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *NetworkLatency) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *NetworkLatency) GetP10() float64 {
+	if x != nil {
+		return x.P10
+	}
+	return 0
+}
+
+func (x *NetworkLatency) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *NetworkLatency) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+type GetLatencySummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Networks with claimable deposits in the window
+	Networks []*NetworkLatency `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	// Window of the deposits in seconds
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *GetLatencySummaryResponse) Reset() {
+	*x = GetLatencySummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatencySummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatencySummaryResponse) ProtoMessage() {}
+
+func (x *GetLatencySummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatencySummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetLatencySummaryResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetLatencySummaryResponse) GetNetworks() []*NetworkLatency {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *GetLatencySummaryResponse) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+type GetBridgeByTxHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *GetBridgeByTxHashRequest) Reset() {
+	*x = GetBridgeByTxHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgeByTxHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgeByTxHashRequest) ProtoMessage() {}
+
+func (x *GetBridgeByTxHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgeByTxHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBridgeByTxHashRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetBridgeByTxHashRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type GetBridgeByTxHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deposits []*Deposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
+}
+
+func (x *GetBridgeByTxHashResponse) Reset() {
+	*x = GetBridgeByTxHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBridgeByTxHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBridgeByTxHashResponse) ProtoMessage() {}
+
+func (x *GetBridgeByTxHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBridgeByTxHashResponse.ProtoReflect.Descriptor instead.
+func (*GetBridgeByTxHashResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetBridgeByTxHashResponse) GetDeposits() []*Deposit {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+type GetClaimByTxHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *GetClaimByTxHashRequest) Reset() {
+	*x = GetClaimByTxHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClaimByTxHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimByTxHashRequest) ProtoMessage() {}
+
+func (x *GetClaimByTxHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimByTxHashRequest.ProtoReflect.Descriptor instead.
+func (*GetClaimByTxHashRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetClaimByTxHashRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type GetClaimByTxHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claims []*Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+}
+
+func (x *GetClaimByTxHashResponse) Reset() {
+	*x = GetClaimByTxHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClaimByTxHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimByTxHashResponse) ProtoMessage() {}
+
+func (x *GetClaimByTxHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimByTxHashResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimByTxHashResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetClaimByTxHashResponse) GetClaims() []*Claim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+type GetClaimsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Claims   []*Claim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	TotalCnt uint64   `protobuf:"varint,2,opt,name=total_cnt,json=totalCnt,proto3" json:"total_cnt,omitempty"`
+	// Cursor of the next page, empty if this is the last page
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetClaimsResponse) Reset() {
+	*x = GetClaimsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClaimsResponse) ProtoMessage() {}
+
+func (x *GetClaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClaimsResponse.ProtoReflect.Descriptor instead.
+func (*GetClaimsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetClaimsResponse) GetClaims() []*Claim {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *GetClaimsResponse) GetTotalCnt() uint64 {
+	if x != nil {
+		return x.TotalCnt
+	}
+	return 0
+}
+
+func (x *GetClaimsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Network message
+type Network struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetworkId  uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	RpcUrl     string `protobuf:"bytes,2,opt,name=rpc_url,json=rpcUrl,proto3" json:"rpc_url,omitempty"`
+	BridgeAddr string `protobuf:"bytes,3,opt,name=bridge_addr,json=bridgeAddr,proto3" json:"bridge_addr,omitempty"`
+	StartBlock uint64 `protobuf:"varint,4,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	TreeIndex  uint32 `protobuf:"varint,5,opt,name=tree_index,json=treeIndex,proto3" json:"tree_index,omitempty"`
+	Active     bool   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *Network) Reset() {
+	*x = Network{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Network) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Network) ProtoMessage() {}
+
+func (x *Network) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Network.ProtoReflect.Descriptor instead.
+func (*Network) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Network) GetNetworkId() uint32 {
+	if x != nil {
+		return x.NetworkId
+	}
+	return 0
+}
+
+func (x *Network) GetRpcUrl() string {
+	if x != nil {
+		return x.RpcUrl
+	}
+	return ""
+}
+
+func (x *Network) GetBridgeAddr() string {
+	if x != nil {
+		return x.BridgeAddr
+	}
+	return ""
+}
+
+func (x *Network) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *Network) GetTreeIndex() uint32 {
+	if x != nil {
+		return x.TreeIndex
+	}
+	return 0
+}
+
+func (x *Network) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type RegisterNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RpcUrl     string `protobuf:"bytes,1,opt,name=rpc_url,json=rpcUrl,proto3" json:"rpc_url,omitempty"`
+	BridgeAddr string `protobuf:"bytes,2,opt,name=bridge_addr,json=bridgeAddr,proto3" json:"bridge_addr,omitempty"`
+	StartBlock uint64 `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+}
+
+func (x *RegisterNetworkRequest) Reset() {
+	*x = RegisterNetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterNetworkRequest) ProtoMessage() {}
+
+func (x *RegisterNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterNetworkRequest.ProtoReflect.Descriptor instead.
+func (*RegisterNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RegisterNetworkRequest) GetRpcUrl() string {
+	if x != nil {
+		return x.RpcUrl
+	}
+	return ""
+}
+
+func (x *RegisterNetworkRequest) GetBridgeAddr() string {
+	if x != nil {
+		return x.BridgeAddr
+	}
+	return ""
+}
+
+func (x *RegisterNetworkRequest) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+type RegisterNetworkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network *Network `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+}
+
+func (x *RegisterNetworkResponse) Reset() {
+	*x = RegisterNetworkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterNetworkResponse) ProtoMessage() {}
+
+func (x *RegisterNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterNetworkResponse.ProtoReflect.Descriptor instead.
+func (*RegisterNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RegisterNetworkResponse) GetNetwork() *Network {
+	if x != nil {
+		return x.Network
+	}
+	return nil
+}
+
+type DeregisterNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetId uint32 `protobuf:"varint,1,opt,name=net_id,json=netId,proto3" json:"net_id,omitempty"`
+}
+
+func (x *DeregisterNetworkRequest) Reset() {
+	*x = DeregisterNetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterNetworkRequest) ProtoMessage() {}
+
+func (x *DeregisterNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterNetworkRequest.ProtoReflect.Descriptor instead.
+func (*DeregisterNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeregisterNetworkRequest) GetNetId() uint32 {
+	if x != nil {
+		return x.NetId
+	}
+	return 0
+}
+
+type DeregisterNetworkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeregisterNetworkResponse) Reset() {
+	*x = DeregisterNetworkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterNetworkResponse) ProtoMessage() {}
+
+func (x *DeregisterNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterNetworkResponse.ProtoReflect.Descriptor instead.
+func (*DeregisterNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{47}
+}
+
+type GetNetworksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNetworksRequest) Reset() {
+	*x = GetNetworksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworksRequest) ProtoMessage() {}
+
+func (x *GetNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworksRequest.ProtoReflect.Descriptor instead.
+func (*GetNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{48}
+}
+
+type GetNetworksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Networks []*Network `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+}
+
+func (x *GetNetworksResponse) Reset() {
+	*x = GetNetworksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNetworksResponse) ProtoMessage() {}
+
+func (x *GetNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNetworksResponse.ProtoReflect.Descriptor instead.
+func (*GetNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetNetworksResponse) GetNetworks() []*Network {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type GetProofCacheStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProofCacheStatsRequest) Reset() {
+	*x = GetProofCacheStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProofCacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofCacheStatsRequest) ProtoMessage() {}
+
+func (x *GetProofCacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetProofCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{50}
+}
+
+type GetProofCacheStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Size      uint64  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Entries   uint64  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	Hits      uint64  `protobuf:"varint,3,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses    uint64  `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions uint64  `protobuf:"varint,5,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	HitRate   float64 `protobuf:"fixed64,6,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+}
+
+func (x *GetProofCacheStatsResponse) Reset() {
+	*x = GetProofCacheStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProofCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProofCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetProofCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProofCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetProofCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetProofCacheStatsResponse) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetProofCacheStatsResponse) GetEntries() uint64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *GetProofCacheStatsResponse) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *GetProofCacheStatsResponse) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *GetProofCacheStatsResponse) GetEvictions() uint64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+func (x *GetProofCacheStatsResponse) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+// DepositStatusEvent message
+type DepositStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    DepositEventType `protobuf:"varint,1,opt,name=type,proto3,enum=bridge.v1.DepositEventType" json:"type,omitempty"`
+	Deposit *Deposit         `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *DepositStatusEvent) Reset() {
+	*x = DepositStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositStatusEvent) ProtoMessage() {}
+
+func (x *DepositStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositStatusEvent.ProtoReflect.Descriptor instead.
+func (*DepositStatusEvent) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DepositStatusEvent) GetType() DepositEventType {
+	if x != nil {
+		return x.Type
+	}
+	return DepositEventType_DEPOSIT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *DepositStatusEvent) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// WebhookSubscription message
+type WebhookSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Destination address of the deposits
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Destination network of the deposits, all the networks if it isn't set
+	NetId      *uint32            `protobuf:"varint,4,opt,name=net_id,json=netId,proto3,oneof" json:"net_id,omitempty"`
+	EventTypes []DepositEventType `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=bridge.v1.DepositEventType" json:"event_types,omitempty"`
+	// Creation time in unix seconds
+	CreatedAt int64 `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WebhookSubscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetNetId() uint32 {
+	if x != nil && x.NetId != nil {
+		return *x.NetId
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetEventTypes() []DepositEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url        string             `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Address    string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	NetId      *uint32            `protobuf:"varint,3,opt,name=net_id,json=netId,proto3,oneof" json:"net_id,omitempty"`
+	EventTypes []DepositEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=bridge.v1.DepositEventType" json:"event_types,omitempty"`
+	// Key of the HMAC-SHA256 signature of the deliveries, a random one is generated if it is empty
+	Secret string `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetNetId() uint32 {
+	if x != nil && x.NetId != nil {
+		return *x.NetId
+	}
+	return 0
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []DepositEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *WebhookSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// The secret is only returned when the subscription is created
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{57}
+}
+
+type GetWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetWebhookSubscriptionsRequest) Reset() {
+	*x = GetWebhookSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *GetWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{58}
+}
+
+type GetWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *GetWebhookSubscriptionsResponse) Reset() {
+	*x = GetWebhookSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *GetWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// WebhookDeadLetter message
+type WebhookDeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             uint64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId uint64           `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventType      DepositEventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=bridge.v1.DepositEventType" json:"event_type,omitempty"`
+	// JSON body of the delivery
+	Payload   string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts  uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Creation and last attempt times in unix seconds
+	CreatedAt int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FailedAt  int64 `protobuf:"varint,8,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+}
+
+func (x *WebhookDeadLetter) Reset() {
+	*x = WebhookDeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeadLetter) ProtoMessage() {}
+
+func (x *WebhookDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeadLetter.ProtoReflect.Descriptor instead.
+func (*WebhookDeadLetter) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WebhookDeadLetter) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetter) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetter) GetEventType() DepositEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return DepositEventType_DEPOSIT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDeadLetter) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookDeadLetter) GetFailedAt() int64 {
+	if x != nil {
+		return x.FailedAt
+	}
+	return 0
+}
+
+type GetWebhookDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId *uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	Offset         uint64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit          uint32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetWebhookDeadLettersRequest) Reset() {
+	*x = GetWebhookDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeadLettersRequest) ProtoMessage() {}
+
+func (x *GetWebhookDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetWebhookDeadLettersRequest) GetSubscriptionId() uint64 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *GetWebhookDeadLettersRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetWebhookDeadLettersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetWebhookDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetters []*WebhookDeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+}
+
+func (x *GetWebhookDeadLettersResponse) Reset() {
+	*x = GetWebhookDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWebhookDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeadLettersResponse) ProtoMessage() {}
+
+func (x *GetWebhookDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetWebhookDeadLettersResponse) GetDeadLetters() []*WebhookDeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayWebhookDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The dead letters of the subscription with the ids are replayed, the empty fields match all of them
+	SubscriptionId *uint64  `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3,oneof" json:"subscription_id,omitempty"`
+	Ids            []uint64 `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *ReplayWebhookDeadLettersRequest) Reset() {
+	*x = ReplayWebhookDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayWebhookDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayWebhookDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ReplayWebhookDeadLettersRequest) GetSubscriptionId() uint64 {
+	if x != nil && x.SubscriptionId != nil {
+		return *x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ReplayWebhookDeadLettersRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ReplayWebhookDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replayed uint64 `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+}
+
+func (x *ReplayWebhookDeadLettersResponse) Reset() {
+	*x = ReplayWebhookDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_query_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayWebhookDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayWebhookDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_query_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_query_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReplayWebhookDeadLettersResponse) GetReplayed() uint64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+var File_query_proto protoreflect.FileDescriptor
+
+var file_query_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f,
+	0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e,
+	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0xd6, 0x03, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
+	0x72, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x72, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x63, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22,
+	0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26,
+	0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x46, 0x6f,
+	0x72, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x27, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x74, 0x61, 0x52, 0x03, 0x65, 0x74, 0x61,
+	0x22, 0x93, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x74, 0x61, 0x12,
+	0x25, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65,
+	0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4e, 0x65, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x5f, 0x63, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa2, 0x02, 0x0a, 0x0a, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcd, 0x01, 0x0a,
+	0x05, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x69,
+	0x6e, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75,
+	0x70, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x92, 0x01, 0x0a,
+	0x11, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xb0, 0x04, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20,
+	0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x07, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x06, 0x74, 0x6f, 0x54,
+	0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x65,
+	0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x5f,
+	0x6e, 0x65, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1a, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x43, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x63, 0x6e, 0x74, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61,
+	0x6c, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x69,
+	0x6e, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x65, 0x74, 0x45, 0x78, 0x69,
+	0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22,
+	0x44, 0x0a, 0x0a, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a,
+	0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x45, 0x78,
+	0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f,
+	0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x69, 0x6e, 0x6e, 0x65, 0x74,
+	0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67,
+	0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67,
+	0x4e, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74,
+	0x22, 0x66, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x52, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x6e, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x65,
+	0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0x75, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x24, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x22, 0x82,
+	0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x42, 0x79, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22,
+	0xca, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x36, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x4d,
+	0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x66, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x56, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x22, 0x77, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6e, 0x74, 0x22, 0x3f, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x41,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x07, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x0e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x31, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x70, 0x31, 0x30, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x35, 0x30, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x70, 0x35, 0x30, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x30, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x39, 0x30, 0x22, 0x6a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x22, 0x33, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x4b, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x08, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x44, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x22, 0x7b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xba, 0x01,
+	0x0a, 0x07, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x70, 0x63, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x70, 0x63, 0x55, 0x72,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x72, 0x65, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x73, 0x0a, 0x16, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x70, 0x63, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x70, 0x63, 0x55, 0x72, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
+	0x47, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x31, 0x0a, 0x18, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68,
+	0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x69, 0x74,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x68, 0x69, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x22, 0x73, 0x0a, 0x12, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x13, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
+	0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0b, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x22, 0xcb, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3c,
+	0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x7f, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x32, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x67, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x99, 0x02, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x22, 0x60, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x75, 0x0a, 0x1f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x20, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x2a, 0x8b, 0x02, 0x0a, 0x13, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23,
+	0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d,
+	0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x03, 0x12, 0x2b, 0x0a,
+	0x27, 0x50, 0x52, 0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45,
+	0x58, 0x49, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x04, 0x12, 0x2c, 0x0a, 0x28, 0x50, 0x52,
+	0x4f, 0x4f, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4d, 0x49,
+	0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x2a, 0x8b, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46,
+	0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x41,
+	0x49, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xfc, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x50, 0x4f, 0x53,
+	0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x21, 0x0a,
+	0x1d, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45,
+	0x58, 0x49, 0x54, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x5f, 0x4f, 0x4e, 0x5f, 0x4c, 0x31, 0x10, 0x03,
+	0x12, 0x20, 0x0a, 0x1c, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x05, 0x12,
+	0x19, 0x0a, 0x15, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4f, 0x52,
+	0x47, 0x45, 0x44, 0x10, 0x07, 0x2a, 0xbe, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x45, 0x44, 0x10, 0x01, 0x12, 0x26,
+	0x0a, 0x22, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x43,
+	0x4c, 0x41, 0x49, 0x4d, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x41,
+	0x49, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49,
+	0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x44, 0x10, 0x04, 0x32, 0x92, 0x0f, 0x0a, 0x0d, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x50, 0x49, 0x12, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x06, 0x12, 0x04, 0x2f, 0x61, 0x70, 0x69, 0x12, 0x73, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x14,
+	0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x7d, 0x5a, 0x0a, 0x12, 0x08, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
+	0x12, 0x83, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x42,
+	0x79, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x42, 0x79,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2d, 0x62,
+	0x79, 0x2d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x7c, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x28, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x2d, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0f, 0x12, 0x0d, 0x2f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x12, 0x61, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1b, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x12, 0x57, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x12, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x7c, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x24, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x2d, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x23, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x12, 0x12, 0x10, 0x2f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x2d, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42,
+	0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x62, 0x79, 0x2d, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x7b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x42, 0x79,
+	0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x2d, 0x62, 0x79, 0x2d, 0x74, 0x78, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x7d, 0x12, 0x63, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12,
+	0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x15, 0x12, 0x13, 0x2f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x66, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a,
+	0x22, 0x0d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x2d, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x53, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x08, 0x12, 0x06, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x9e, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x2d, 0x62, 0x79, 0x2d, 0x61, 0x64, 0x64,
+	0x72, 0x2f, 0x7b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x73, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x22, 0x2e, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x32, 0xdc, 0x09, 0x0a, 0x0c,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x74, 0x0a, 0x0f,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x3a,
+	0x01, 0x2a, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x23, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x2a, 0x18, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x7b, 0x6e, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x65, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x7d, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x24, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x62, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x94, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x16, 0x2a, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e,
+	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x2d, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x2a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x2d, 0x64, 0x65, 0x61, 0x64, 0x2d, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x3a, 0x01, 0x2a, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78, 0x50, 0x6f, 0x6c, 0x79, 0x67,
+	0x6f, 0x6e, 0x48, 0x65, 0x72, 0x6d, 0x65, 0x7a, 0x2f, 0x7a, 0x6b, 0x65, 0x76, 0x6d, 0x2d, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x74, 0x72, 0x65, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_query_proto_rawDescOnce sync.Once
+	file_query_proto_rawDescData = file_query_proto_rawDesc
+)
+
+func file_query_proto_rawDescGZIP() []byte {
+	file_query_proto_rawDescOnce.Do(func() {
+		file_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_query_proto_rawDescData)
+	})
+	return file_query_proto_rawDescData
+}
+
+var file_query_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_query_proto_goTypes = []interface{}{
+	(ProofMismatchReason)(0),                  // 0: bridge.v1.ProofMismatchReason
+	(DepositStatus)(0),                        // 1: bridge.v1.DepositStatus
+	(DepositState)(0),                         // 2: bridge.v1.DepositState
+	(DepositEventType)(0),                     // 3: bridge.v1.DepositEventType
+	(*TokenWrapped)(nil),                      // 4: bridge.v1.TokenWrapped
+	(*Deposit)(nil),                           // 5: bridge.v1.Deposit
+	(*DepositEta)(nil),                        // 6: bridge.v1.DepositEta
+	(*Claim)(nil),                             // 7: bridge.v1.Claim
+	(*TokenVolume)(nil),                       // 8: bridge.v1.TokenVolume
+	(*TokenStats)(nil),                        // 9: bridge.v1.TokenStats
+	(*Proof)(nil),                             // 10: bridge.v1.Proof
+	(*DepositTransition)(nil),                 // 11: bridge.v1.DepositTransition
+	(*CheckAPIRequest)(nil),                   // 12: bridge.v1.CheckAPIRequest
+	(*GetBridgesRequest)(nil),                 // 13: bridge.v1.GetBridgesRequest
+	(*SubscribeDepositStatusRequest)(nil),     // 14: bridge.v1.SubscribeDepositStatusRequest
+	(*GetProofRequest)(nil),                   // 15: bridge.v1.GetProofRequest
+	(*DepositKey)(nil),                        // 16: bridge.v1.DepositKey
+	(*GetProofsRequest)(nil),                  // 17: bridge.v1.GetProofsRequest
+	(*VerifyProofRequest)(nil),                // 18: bridge.v1.VerifyProofRequest
+	(*GetTokenWrappedRequest)(nil),            // 19: bridge.v1.GetTokenWrappedRequest
+	(*GetTokenWrappedByAddressRequest)(nil),   // 20: bridge.v1.GetTokenWrappedByAddressRequest
+	(*ListTokenWrappedRequest)(nil),           // 21: bridge.v1.ListTokenWrappedRequest
+	(*GetBridgeRequest)(nil),                  // 22: bridge.v1.GetBridgeRequest
+	(*GetStatsRequest)(nil),                   // 23: bridge.v1.GetStatsRequest
+	(*GetClaimsRequest)(nil),                  // 24: bridge.v1.GetClaimsRequest
+	(*CheckAPIResponse)(nil),                  // 25: bridge.v1.CheckAPIResponse
+	(*GetBridgesBySenderRequest)(nil),         // 26: bridge.v1.GetBridgesBySenderRequest
+	(*GetBridgesResponse)(nil),                // 27: bridge.v1.GetBridgesResponse
+	(*GetProofResponse)(nil),                  // 28: bridge.v1.GetProofResponse
+	(*ProofResult)(nil),                       // 29: bridge.v1.ProofResult
+	(*GetProofsResponse)(nil),                 // 30: bridge.v1.GetProofsResponse
+	(*VerifyProofResponse)(nil),               // 31: bridge.v1.VerifyProofResponse
+	(*GetTokenWrappedResponse)(nil),           // 32: bridge.v1.GetTokenWrappedResponse
+	(*ListTokenWrappedResponse)(nil),          // 33: bridge.v1.ListTokenWrappedResponse
+	(*GetStatsResponse)(nil),                  // 34: bridge.v1.GetStatsResponse
+	(*GetBridgeResponse)(nil),                 // 35: bridge.v1.GetBridgeResponse
+	(*GetDepositTimelineRequest)(nil),         // 36: bridge.v1.GetDepositTimelineRequest
+	(*GetDepositTimelineResponse)(nil),        // 37: bridge.v1.GetDepositTimelineResponse
+	(*DepositHistory)(nil),                    // 38: bridge.v1.DepositHistory
+	(*GetLatencySummaryRequest)(nil),          // 39: bridge.v1.GetLatencySummaryRequest
+	(*NetworkLatency)(nil),                    // 40: bridge.v1.NetworkLatency
+	(*GetLatencySummaryResponse)(nil),         // 41: bridge.v1.GetLatencySummaryResponse
+	(*GetBridgeByTxHashRequest)(nil),          // 42: bridge.v1.GetBridgeByTxHashRequest
+	(*GetBridgeByTxHashResponse)(nil),         // 43: bridge.v1.GetBridgeByTxHashResponse
+	(*GetClaimByTxHashRequest)(nil),           // 44: bridge.v1.GetClaimByTxHashRequest
+	(*GetClaimByTxHashResponse)(nil),          // 45: bridge.v1.GetClaimByTxHashResponse
+	(*GetClaimsResponse)(nil),                 // 46: bridge.v1.GetClaimsResponse
+	(*Network)(nil),                           // 47: bridge.v1.Network
+	(*RegisterNetworkRequest)(nil),            // 48: bridge.v1.RegisterNetworkRequest
+	(*RegisterNetworkResponse)(nil),           // 49: bridge.v1.RegisterNetworkResponse
+	(*DeregisterNetworkRequest)(nil),          // 50: bridge.v1.DeregisterNetworkRequest
+	(*DeregisterNetworkResponse)(nil),         // 51: bridge.v1.DeregisterNetworkResponse
+	(*GetNetworksRequest)(nil),                // 52: bridge.v1.GetNetworksRequest
+	(*GetNetworksResponse)(nil),               // 53: bridge.v1.GetNetworksResponse
+	(*GetProofCacheStatsRequest)(nil),         // 54: bridge.v1.GetProofCacheStatsRequest
+	(*GetProofCacheStatsResponse)(nil),        // 55: bridge.v1.GetProofCacheStatsResponse
+	(*DepositStatusEvent)(nil),                // 56: bridge.v1.DepositStatusEvent
+	(*WebhookSubscription)(nil),               // 57: bridge.v1.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),  // 58: bridge.v1.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil), // 59: bridge.v1.CreateWebhookSubscriptionResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 60: bridge.v1.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil), // 61: bridge.v1.DeleteWebhookSubscriptionResponse
+	(*GetWebhookSubscriptionsRequest)(nil),    // 62: bridge.v1.GetWebhookSubscriptionsRequest
+	(*GetWebhookSubscriptionsResponse)(nil),   // 63: bridge.v1.GetWebhookSubscriptionsResponse
+	(*WebhookDeadLetter)(nil),                 // 64: bridge.v1.WebhookDeadLetter
+	(*GetWebhookDeadLettersRequest)(nil),      // 65: bridge.v1.GetWebhookDeadLettersRequest
+	(*GetWebhookDeadLettersResponse)(nil),     // 66: bridge.v1.GetWebhookDeadLettersResponse
+	(*ReplayWebhookDeadLettersRequest)(nil),   // 67: bridge.v1.ReplayWebhookDeadLettersRequest
+	(*ReplayWebhookDeadLettersResponse)(nil),  // 68: bridge.v1.ReplayWebhookDeadLettersResponse
+}
+var file_query_proto_depIdxs = []int32{
+	6,  // 0: bridge.v1.Deposit.eta:type_name -> bridge.v1.DepositEta
+	8,  // 1: bridge.v1.TokenStats.total:type_name -> bridge.v1.TokenVolume
+	8,  // 2: bridge.v1.TokenStats.windows:type_name -> bridge.v1.TokenVolume
+	2,  // 3: bridge.v1.DepositTransition.state:type_name -> bridge.v1.DepositState
+	1,  // 4: bridge.v1.GetBridgesRequest.status:type_name -> bridge.v1.DepositStatus
+	16, // 5: bridge.v1.GetProofsRequest.deposits:type_name -> bridge.v1.DepositKey
+	5,  // 6: bridge.v1.GetBridgesResponse.deposits:type_name -> bridge.v1.Deposit
+	10, // 7: bridge.v1.GetProofResponse.proof:type_name -> bridge.v1.Proof
+	10, // 8: bridge.v1.ProofResult.proof:type_name -> bridge.v1.Proof
+	29, // 9: bridge.v1.GetProofsResponse.proofs:type_name -> bridge.v1.ProofResult
+	0,  // 10: bridge.v1.VerifyProofResponse.reason:type_name -> bridge.v1.ProofMismatchReason
+	4,  // 11: bridge.v1.GetTokenWrappedResponse.tokenwrapped:type_name -> bridge.v1.TokenWrapped
+	4,  // 12: bridge.v1.ListTokenWrappedResponse.tokens_wrapped:type_name -> bridge.v1.TokenWrapped
+	9,  // 13: bridge.v1.GetStatsResponse.stats:type_name -> bridge.v1.TokenStats
+	5,  // 14: bridge.v1.GetBridgeResponse.deposit:type_name -> bridge.v1.Deposit
+	2,  // 15: bridge.v1.GetDepositTimelineResponse.state:type_name -> bridge.v1.DepositState
+	11, // 16: bridge.v1.GetDepositTimelineResponse.transitions:type_name -> bridge.v1.DepositTransition
+	38, // 17: bridge.v1.GetDepositTimelineResponse.reorged:type_name -> bridge.v1.DepositHistory
+	11, // 18: bridge.v1.DepositHistory.transitions:type_name -> bridge.v1.DepositTransition
+	40, // 19: bridge.v1.GetLatencySummaryResponse.networks:type_name -> bridge.v1.NetworkLatency
+	5,  // 20: bridge.v1.GetBridgeByTxHashResponse.deposits:type_name -> bridge.v1.Deposit
+	7,  // 21: bridge.v1.GetClaimByTxHashResponse.claims:type_name -> bridge.v1.Claim
+	7,  // 22: bridge.v1.GetClaimsResponse.claims:type_name -> bridge.v1.Claim
+	47, // 23: bridge.v1.RegisterNetworkResponse.network:type_name -> bridge.v1.Network
+	47, // 24: bridge.v1.GetNetworksResponse.networks:type_name -> bridge.v1.Network
+	3,  // 25: bridge.v1.DepositStatusEvent.type:type_name -> bridge.v1.DepositEventType
+	5,  // 26: bridge.v1.DepositStatusEvent.deposit:type_name -> bridge.v1.Deposit
+	3,  // 27: bridge.v1.WebhookSubscription.event_types:type_name -> bridge.v1.DepositEventType
+	3,  // 28: bridge.v1.CreateWebhookSubscriptionRequest.event_types:type_name -> bridge.v1.DepositEventType
+	57, // 29: bridge.v1.CreateWebhookSubscriptionResponse.subscription:type_name -> bridge.v1.WebhookSubscription
+	57, // 30: bridge.v1.GetWebhookSubscriptionsResponse.subscriptions:type_name -> bridge.v1.WebhookSubscription
+	3,  // 31: bridge.v1.WebhookDeadLetter.event_type:type_name -> bridge.v1.DepositEventType
+	64, // 32: bridge.v1.GetWebhookDeadLettersResponse.dead_letters:type_name -> bridge.v1.WebhookDeadLetter
+	12, // 33: bridge.v1.BridgeService.CheckAPI:input_type -> bridge.v1.CheckAPIRequest
+	13, // 34: bridge.v1.BridgeService.GetBridges:input_type -> bridge.v1.GetBridgesRequest
+	26, // 35: bridge.v1.BridgeService.GetBridgesBySender:input_type -> bridge.v1.GetBridgesBySenderRequest
+	14, // 36: bridge.v1.BridgeService.SubscribeDepositStatus:input_type -> bridge.v1.SubscribeDepositStatusRequest
+	15, // 37: bridge.v1.BridgeService.GetProof:input_type -> bridge.v1.GetProofRequest
+	17, // 38: bridge.v1.BridgeService.GetProofs:input_type -> bridge.v1.GetProofsRequest
+	22, // 39: bridge.v1.BridgeService.GetBridge:input_type -> bridge.v1.GetBridgeRequest
+	36, // 40: bridge.v1.BridgeService.GetDepositTimeline:input_type -> bridge.v1.GetDepositTimelineRequest
+	39, // 41: bridge.v1.BridgeService.GetLatencySummary:input_type -> bridge.v1.GetLatencySummaryRequest
+	42, // 42: bridge.v1.BridgeService.GetBridgeByTxHash:input_type -> bridge.v1.GetBridgeByTxHashRequest
+	44, // 43: bridge.v1.BridgeService.GetClaimByTxHash:input_type -> bridge.v1.GetClaimByTxHashRequest
+	24, // 44: bridge.v1.BridgeService.GetClaims:input_type -> bridge.v1.GetClaimsRequest
+	18, // 45: bridge.v1.BridgeService.VerifyProof:input_type -> bridge.v1.VerifyProofRequest
+	23, // 46: bridge.v1.BridgeService.GetStats:input_type -> bridge.v1.GetStatsRequest
+	19, // 47: bridge.v1.BridgeService.GetTokenWrapped:input_type -> bridge.v1.GetTokenWrappedRequest
+	20, // 48: bridge.v1.BridgeService.GetTokenWrappedByAddress:input_type -> bridge.v1.GetTokenWrappedByAddressRequest
+	21, // 49: bridge.v1.BridgeService.ListTokenWrapped:input_type -> bridge.v1.ListTokenWrappedRequest
+	48, // 50: bridge.v1.AdminService.RegisterNetwork:input_type -> bridge.v1.RegisterNetworkRequest
+	50, // 51: bridge.v1.AdminService.DeregisterNetwork:input_type -> bridge.v1.DeregisterNetworkRequest
+	52, // 52: bridge.v1.AdminService.GetNetworks:input_type -> bridge.v1.GetNetworksRequest
+	54, // 53: bridge.v1.AdminService.GetProofCacheStats:input_type -> bridge.v1.GetProofCacheStatsRequest
+	58, // 54: bridge.v1.AdminService.CreateWebhookSubscription:input_type -> bridge.v1.CreateWebhookSubscriptionRequest
+	60, // 55: bridge.v1.AdminService.DeleteWebhookSubscription:input_type -> bridge.v1.DeleteWebhookSubscriptionRequest
+	62, // 56: bridge.v1.AdminService.GetWebhookSubscriptions:input_type -> bridge.v1.GetWebhookSubscriptionsRequest
+	65, // 57: bridge.v1.AdminService.GetWebhookDeadLetters:input_type -> bridge.v1.GetWebhookDeadLettersRequest
+	67, // 58: bridge.v1.AdminService.ReplayWebhookDeadLetters:input_type -> bridge.v1.ReplayWebhookDeadLettersRequest
+	25, // 59: bridge.v1.BridgeService.CheckAPI:output_type -> bridge.v1.CheckAPIResponse
+	27, // 60: bridge.v1.BridgeService.GetBridges:output_type -> bridge.v1.GetBridgesResponse
+	27, // 61: bridge.v1.BridgeService.GetBridgesBySender:output_type -> bridge.v1.GetBridgesResponse
+	56, // 62: bridge.v1.BridgeService.SubscribeDepositStatus:output_type -> bridge.v1.DepositStatusEvent
+	28, // 63: bridge.v1.BridgeService.GetProof:output_type -> bridge.v1.GetProofResponse
+	30, // 64: bridge.v1.BridgeService.GetProofs:output_type -> bridge.v1.GetProofsResponse
+	35, // 65: bridge.v1.BridgeService.GetBridge:output_type -> bridge.v1.GetBridgeResponse
+	37, // 66: bridge.v1.BridgeService.GetDepositTimeline:output_type -> bridge.v1.GetDepositTimelineResponse
+	41, // 67: bridge.v1.BridgeService.GetLatencySummary:output_type -> bridge.v1.GetLatencySummaryResponse
+	43, // 68: bridge.v1.BridgeService.GetBridgeByTxHash:output_type -> bridge.v1.GetBridgeByTxHashResponse
+	45, // 69: bridge.v1.BridgeService.GetClaimByTxHash:output_type -> bridge.v1.GetClaimByTxHashResponse
+	46, // 70: bridge.v1.BridgeService.GetClaims:output_type -> bridge.v1.GetClaimsResponse
+	31, // 71: bridge.v1.BridgeService.VerifyProof:output_type -> bridge.v1.VerifyProofResponse
+	34, // 72: bridge.v1.BridgeService.GetStats:output_type -> bridge.v1.GetStatsResponse
+	32, // 73: bridge.v1.BridgeService.GetTokenWrapped:output_type -> bridge.v1.GetTokenWrappedResponse
+	32, // 74: bridge.v1.BridgeService.GetTokenWrappedByAddress:output_type -> bridge.v1.GetTokenWrappedResponse
+	33, // 75: bridge.v1.BridgeService.ListTokenWrapped:output_type -> bridge.v1.ListTokenWrappedResponse
+	49, // 76: bridge.v1.AdminService.RegisterNetwork:output_type -> bridge.v1.RegisterNetworkResponse
+	51, // 77: bridge.v1.AdminService.DeregisterNetwork:output_type -> bridge.v1.DeregisterNetworkResponse
+	53, // 78: bridge.v1.AdminService.GetNetworks:output_type -> bridge.v1.GetNetworksResponse
+	55, // 79: bridge.v1.AdminService.GetProofCacheStats:output_type -> bridge.v1.GetProofCacheStatsResponse
+	59, // 80: bridge.v1.AdminService.CreateWebhookSubscription:output_type -> bridge.v1.CreateWebhookSubscriptionResponse
+	61, // 81: bridge.v1.AdminService.DeleteWebhookSubscription:output_type -> bridge.v1.DeleteWebhookSubscriptionResponse
+	63, // 82: bridge.v1.AdminService.GetWebhookSubscriptions:output_type -> bridge.v1.GetWebhookSubscriptionsResponse
+	66, // 83: bridge.v1.AdminService.GetWebhookDeadLetters:output_type -> bridge.v1.GetWebhookDeadLettersResponse
+	68, // 84: bridge.v1.AdminService.ReplayWebhookDeadLetters:output_type -> bridge.v1.ReplayWebhookDeadLettersResponse
+	59, // [59:85] is the sub-list for method output_type
+	33, // [33:59] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
+}
+
+func init() { file_query_proto_init() }
+func file_query_proto_init() {
+	if File_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenWrapped); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositEta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Claim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAPIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeDepositStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyProofRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenWrappedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenWrappedByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTokenWrappedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAPIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgesBySenderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProofResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyProofResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTokenWrappedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTokenWrappedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepositTimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDepositTimelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatencySummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatencySummaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeByTxHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBridgeByTxHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimByTxHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimByTxHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClaimsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Network); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterNetworkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetClaimsResponse.ProtoReflect.Descriptor instead.
-func (*GetClaimsResponse) Descriptor() ([]byte, []int) {
-	return file_query_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetClaimsResponse) GetClaims() []*Claim {
-	if x != nil {
-		return x.Claims
-	}
-	return nil
-}
-
-func (x *GetClaimsResponse) GetTotalCnt() uint64 {
-	if x != nil {
-		return x.TotalCnt
-	}
-	return 0
-}
-
-var File_query_proto protoreflect.FileDescriptor
-
-var file_query_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f,
-	0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e,
-	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64,
-	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x22, 0x8c, 0x03, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f,
-	0x72, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6f, 0x72, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x5f, 0x63, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x22,
-	0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26,
-	0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x69,
-	0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x46, 0x6f,
-	0x72, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0xdf, 0x01, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x69, 0x6d,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x12,
-	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x7a, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x50,
-	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x69,
-	0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61,
-	0x69, 0x6e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f,
-	0x6c, 0x6c, 0x75, 0x70, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x45, 0x78, 0x69, 0x74,
-	0x52, 0x6f, 0x6f, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x49, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43,
-	0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72,
-	0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f,
-	0x6f, 0x72, 0x69, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x72, 0x69, 0x67, 0x4e, 0x65, 0x74, 0x22,
-	0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x43, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x24, 0x0a, 0x10, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69,
-	0x22, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x08, 0x64, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x43, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22,
-	0x56, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x22, 0x41, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x5a, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69,
-	0x6d, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6e, 0x74, 0x32, 0xd6, 0x04, 0x0a, 0x0d, 0x42, 0x72, 0x69, 0x64, 0x67,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x41, 0x50, 0x49, 0x12, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x06, 0x12, 0x04, 0x2f, 0x61, 0x70, 0x69, 0x12, 0x67, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
-	0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x7d, 0x12, 0x5a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x1a, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x0f, 0x12, 0x0d, 0x2f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x2d, 0x70, 0x72, 0x6f, 0x6f, 0x66,
-	0x12, 0x57, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x1b, 0x2e,
-	0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x69,
-	0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09,
-	0x12, 0x07, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x63, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x63, 0x6c, 0x61, 0x69,
-	0x6d, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x6f,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x64, 0x12, 0x21, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
-	0x12, 0x0d, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x42,
-	0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x30, 0x78,
-	0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x48, 0x65, 0x72, 0x6d, 0x65, 0x7a, 0x2f, 0x7a, 0x6b,
-	0x65, 0x76, 0x6d, 0x2d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x74, 0x72, 0x65, 0x65, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_query_proto_rawDescOnce sync.Once
-	file_query_proto_rawDescData = file_query_proto_rawDesc
-)
-
-func file_query_proto_rawDescGZIP() []byte {
-	file_query_proto_rawDescOnce.Do(func() {
-		file_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_query_proto_rawDescData)
-	})
-	return file_query_proto_rawDescData
-}
-
-var file_query_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_query_proto_goTypes = []interface{}{
-	(*TokenWrapped)(nil),            // 0: bridge.v1.TokenWrapped
-	(*Deposit)(nil),                 // 1: bridge.v1.Deposit
-	(*Claim)(nil),                   // 2: bridge.v1.Claim
-	(*Proof)(nil),                   // 3: bridge.v1.Proof
-	(*CheckAPIRequest)(nil),         // 4: bridge.v1.CheckAPIRequest
-	(*GetBridgesRequest)(nil),       // 5: bridge.v1.GetBridgesRequest
-	(*GetProofRequest)(nil),         // 6: bridge.v1.GetProofRequest
-	(*GetTokenWrappedRequest)(nil),  // 7: bridge.v1.GetTokenWrappedRequest
-	(*GetBridgeRequest)(nil),        // 8: bridge.v1.GetBridgeRequest
-	(*GetClaimsRequest)(nil),        // 9: bridge.v1.GetClaimsRequest
-	(*CheckAPIResponse)(nil),        // 10: bridge.v1.CheckAPIResponse
-	(*GetBridgesResponse)(nil),      // 11: bridge.v1.GetBridgesResponse
-	(*GetProofResponse)(nil),        // 12: bridge.v1.GetProofResponse
-	(*GetTokenWrappedResponse)(nil), // 13: bridge.v1.GetTokenWrappedResponse
-	(*GetBridgeResponse)(nil),       // 14: bridge.v1.GetBridgeResponse
-	(*GetClaimsResponse)(nil),       // 15: bridge.v1.GetClaimsResponse
-}
-var file_query_proto_depIdxs = []int32{
-	1,  // 0: bridge.v1.GetBridgesResponse.deposits:type_name -> bridge.v1.Deposit
-	3,  // 1: bridge.v1.GetProofResponse.proof:type_name -> bridge.v1.Proof
-	0,  // 2: bridge.v1.GetTokenWrappedResponse.tokenwrapped:type_name -> bridge.v1.TokenWrapped
-	1,  // 3: bridge.v1.GetBridgeResponse.deposit:type_name -> bridge.v1.Deposit
-	2,  // 4: bridge.v1.GetClaimsResponse.claims:type_name -> bridge.v1.Claim
-	4,  // 5: bridge.v1.BridgeService.CheckAPI:input_type -> bridge.v1.CheckAPIRequest
-	5,  // 6: bridge.v1.BridgeService.GetBridges:input_type -> bridge.v1.GetBridgesRequest
-	6,  // 7: bridge.v1.BridgeService.GetProof:input_type -> bridge.v1.GetProofRequest
-	8,  // 8: bridge.v1.BridgeService.GetBridge:input_type -> bridge.v1.GetBridgeRequest
-	9,  // 9: bridge.v1.BridgeService.GetClaims:input_type -> bridge.v1.GetClaimsRequest
-	7,  // 10: bridge.v1.BridgeService.GetTokenWrapped:input_type -> bridge.v1.GetTokenWrappedRequest
-	10, // 11: bridge.v1.BridgeService.CheckAPI:output_type -> bridge.v1.CheckAPIResponse
-	11, // 12: bridge.v1.BridgeService.GetBridges:output_type -> bridge.v1.GetBridgesResponse
-	12, // 13: bridge.v1.BridgeService.GetProof:output_type -> bridge.v1.GetProofResponse
-	14, // 14: bridge.v1.BridgeService.GetBridge:output_type -> bridge.v1.GetBridgeResponse
-	15, // 15: bridge.v1.BridgeService.GetClaims:output_type -> bridge.v1.GetClaimsResponse
-	13, // 16: bridge.v1.BridgeService.GetTokenWrapped:output_type -> bridge.v1.GetTokenWrappedResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
-}
-
-func init() { file_query_proto_init() }
-func file_query_proto_init() {
-	if File_query_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenWrapped); i {
+		file_query_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterNetworkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1302,8 +6013,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Deposit); i {
+		file_query_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterNetworkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1314,8 +6025,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Claim); i {
+		file_query_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterNetworkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1326,8 +6037,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Proof); i {
+		file_query_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetworksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1338,8 +6049,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAPIRequest); i {
+		file_query_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNetworksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1350,8 +6061,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgesRequest); i {
+		file_query_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofCacheStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1362,8 +6073,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProofRequest); i {
+		file_query_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProofCacheStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1374,8 +6085,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenWrappedRequest); i {
+		file_query_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DepositStatusEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1386,8 +6097,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgeRequest); i {
+		file_query_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1398,8 +6109,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClaimsRequest); i {
+		file_query_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1410,8 +6121,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckAPIResponse); i {
+		file_query_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1422,8 +6133,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgesResponse); i {
+		file_query_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1434,8 +6145,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProofResponse); i {
+		file_query_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1446,8 +6157,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTokenWrappedResponse); i {
+		file_query_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookSubscriptionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1458,8 +6169,8 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBridgeResponse); i {
+		file_query_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookSubscriptionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1470,8 +6181,56 @@ func file_query_proto_init() {
 				return nil
 			}
 		}
-		file_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClaimsResponse); i {
+		file_query_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWebhookDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayWebhookDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_query_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayWebhookDeadLettersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1483,18 +6242,28 @@ func file_query_proto_init() {
 			}
 		}
 	}
+	file_query_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[53].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[61].OneofWrappers = []interface{}{}
+	file_query_proto_msgTypes[63].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_query_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      4,
+			NumMessages:   65,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_query_proto_goTypes,
 		DependencyIndexes: file_query_proto_depIdxs,
+		EnumInfos:         file_query_proto_enumTypes,
 		MessageInfos:      file_query_proto_msgTypes,
 	}.Build()
 	File_query_proto = out.File
@@ -27,14 +27,36 @@ type BridgeServiceClient interface {
 	CheckAPI(ctx context.Context, in *CheckAPIRequest, opts ...grpc.CallOption) (*CheckAPIResponse, error)
 	/// Get bridges for the destination address both in L1 and L2
 	GetBridges(ctx context.Context, in *GetBridgesRequest, opts ...grpc.CallOption) (*GetBridgesResponse, error)
+	/// Get bridges sent by the address both in L1 and L2
+	GetBridgesBySender(ctx context.Context, in *GetBridgesBySenderRequest, opts ...grpc.CallOption) (*GetBridgesResponse, error)
+	/// Subscribe to the status changes of the deposits of a destination address or of a specific deposit
+	SubscribeDepositStatus(ctx context.Context, in *SubscribeDepositStatusRequest, opts ...grpc.CallOption) (BridgeService_SubscribeDepositStatusClient, error)
 	/// Get the merkle proof for the specific deposit
 	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error)
+	/// Get the merkle proofs for a list of deposits
+	GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error)
 	/// Get the specific deposit
 	GetBridge(ctx context.Context, in *GetBridgeRequest, opts ...grpc.CallOption) (*GetBridgeResponse, error)
+	/// Get the history of the states of the specific deposit
+	GetDepositTimeline(ctx context.Context, in *GetDepositTimelineRequest, opts ...grpc.CallOption) (*GetDepositTimelineResponse, error)
+	/// Get the distribution of the time the deposits of every network take to be claimable
+	GetLatencySummary(ctx context.Context, in *GetLatencySummaryRequest, opts ...grpc.CallOption) (*GetLatencySummaryResponse, error)
+	/// Get all the deposits of a transaction
+	GetBridgeByTxHash(ctx context.Context, in *GetBridgeByTxHashRequest, opts ...grpc.CallOption) (*GetBridgeByTxHashResponse, error)
+	/// Get all the claims of a transaction
+	GetClaimByTxHash(ctx context.Context, in *GetClaimByTxHashRequest, opts ...grpc.CallOption) (*GetClaimByTxHashResponse, error)
 	/// Get claims for the specific smart contract address both in L1 and L2
 	GetClaims(ctx context.Context, in *GetClaimsRequest, opts ...grpc.CallOption) (*GetClaimsResponse, error)
-	/// Get token wrapped for the specific smart contract address both in L1 and L2
+	/// Verify the merkle proof of the specific deposit against an exit root
+	VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error)
+	/// Get the deposit and claim volumes, the outstanding amounts and the locked value of every token in every network
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	/// Get token wrapped for the specific smart contract address in the destination network
 	GetTokenWrapped(ctx context.Context, in *GetTokenWrappedRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error)
+	/// Get the original token and the metadata of a wrapped token address in a network
+	GetTokenWrappedByAddress(ctx context.Context, in *GetTokenWrappedByAddressRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error)
+	/// Get the wrapped tokens both in L1 and L2
+	ListTokenWrapped(ctx context.Context, in *ListTokenWrappedRequest, opts ...grpc.CallOption) (*ListTokenWrappedResponse, error)
 }
 
 type bridgeServiceClient struct {
@@ -63,6 +85,47 @@ func (c *bridgeServiceClient) GetBridges(ctx context.Context, in *GetBridgesRequ
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetBridgesBySender(ctx context.Context, in *GetBridgesBySenderRequest, opts ...grpc.CallOption) (*GetBridgesResponse, error) {
+	out := new(GetBridgesResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetBridgesBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) SubscribeDepositStatus(ctx context.Context, in *SubscribeDepositStatusRequest, opts ...grpc.CallOption) (BridgeService_SubscribeDepositStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BridgeService_ServiceDesc.Streams[0], "/bridge.v1.BridgeService/SubscribeDepositStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bridgeServiceSubscribeDepositStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BridgeService_SubscribeDepositStatusClient interface {
+	Recv() (*DepositStatusEvent, error)
+	grpc.ClientStream
+}
+
+type bridgeServiceSubscribeDepositStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *bridgeServiceSubscribeDepositStatusClient) Recv() (*DepositStatusEvent, error) {
+	m := new(DepositStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *bridgeServiceClient) GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error) {
 	out := new(GetProofResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetProof", in, out, opts...)
@@ -72,6 +135,15 @@ func (c *bridgeServiceClient) GetProof(ctx context.Context, in *GetProofRequest,
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetProofs(ctx context.Context, in *GetProofsRequest, opts ...grpc.CallOption) (*GetProofsResponse, error) {
+	out := new(GetProofsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetBridge(ctx context.Context, in *GetBridgeRequest, opts ...grpc.CallOption) (*GetBridgeResponse, error) {
 	out := new(GetBridgeResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetBridge", in, out, opts...)
@@ -81,6 +153,42 @@ func (c *bridgeServiceClient) GetBridge(ctx context.Context, in *GetBridgeReques
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetDepositTimeline(ctx context.Context, in *GetDepositTimelineRequest, opts ...grpc.CallOption) (*GetDepositTimelineResponse, error) {
+	out := new(GetDepositTimelineResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetDepositTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetLatencySummary(ctx context.Context, in *GetLatencySummaryRequest, opts ...grpc.CallOption) (*GetLatencySummaryResponse, error) {
+	out := new(GetLatencySummaryResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetLatencySummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetBridgeByTxHash(ctx context.Context, in *GetBridgeByTxHashRequest, opts ...grpc.CallOption) (*GetBridgeByTxHashResponse, error) {
+	out := new(GetBridgeByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetBridgeByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetClaimByTxHash(ctx context.Context, in *GetClaimByTxHashRequest, opts ...grpc.CallOption) (*GetClaimByTxHashResponse, error) {
+	out := new(GetClaimByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetClaimByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetClaims(ctx context.Context, in *GetClaimsRequest, opts ...grpc.CallOption) (*GetClaimsResponse, error) {
 	out := new(GetClaimsResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetClaims", in, out, opts...)
@@ -90,6 +198,24 @@ func (c *bridgeServiceClient) GetClaims(ctx context.Context, in *GetClaimsReques
 	return out, nil
 }
 
+func (c *bridgeServiceClient) VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error) {
+	out := new(VerifyProofResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/VerifyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bridgeServiceClient) GetTokenWrapped(ctx context.Context, in *GetTokenWrappedRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error) {
 	out := new(GetTokenWrappedResponse)
 	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetTokenWrapped", in, out, opts...)
@@ -99,6 +225,24 @@ func (c *bridgeServiceClient) GetTokenWrapped(ctx context.Context, in *GetTokenW
 	return out, nil
 }
 
+func (c *bridgeServiceClient) GetTokenWrappedByAddress(ctx context.Context, in *GetTokenWrappedByAddressRequest, opts ...grpc.CallOption) (*GetTokenWrappedResponse, error) {
+	out := new(GetTokenWrappedResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/GetTokenWrappedByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bridgeServiceClient) ListTokenWrapped(ctx context.Context, in *ListTokenWrappedRequest, opts ...grpc.CallOption) (*ListTokenWrappedResponse, error) {
+	out := new(ListTokenWrappedResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.BridgeService/ListTokenWrapped", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BridgeServiceServer is the server API for BridgeService service.
 // All implementations must embed UnimplementedBridgeServiceServer
 // for forward compatibility
@@ -108,14 +252,36 @@ type BridgeServiceServer interface {
 	CheckAPI(context.Context, *CheckAPIRequest) (*CheckAPIResponse, error)
 	/// Get bridges for the destination address both in L1 and L2
 	GetBridges(context.Context, *GetBridgesRequest) (*GetBridgesResponse, error)
+	/// Get bridges sent by the address both in L1 and L2
+	GetBridgesBySender(context.Context, *GetBridgesBySenderRequest) (*GetBridgesResponse, error)
+	/// Subscribe to the status changes of the deposits of a destination address or of a specific deposit
+	SubscribeDepositStatus(*SubscribeDepositStatusRequest, BridgeService_SubscribeDepositStatusServer) error
 	/// Get the merkle proof for the specific deposit
 	GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error)
+	/// Get the merkle proofs for a list of deposits
+	GetProofs(context.Context, *GetProofsRequest) (*GetProofsResponse, error)
 	/// Get the specific deposit
 	GetBridge(context.Context, *GetBridgeRequest) (*GetBridgeResponse, error)
+	/// Get the history of the states of the specific deposit
+	GetDepositTimeline(context.Context, *GetDepositTimelineRequest) (*GetDepositTimelineResponse, error)
+	/// Get the distribution of the time the deposits of every network take to be claimable
+	GetLatencySummary(context.Context, *GetLatencySummaryRequest) (*GetLatencySummaryResponse, error)
+	/// Get all the deposits of a transaction
+	GetBridgeByTxHash(context.Context, *GetBridgeByTxHashRequest) (*GetBridgeByTxHashResponse, error)
+	/// Get all the claims of a transaction
+	GetClaimByTxHash(context.Context, *GetClaimByTxHashRequest) (*GetClaimByTxHashResponse, error)
 	/// Get claims for the specific smart contract address both in L1 and L2
 	GetClaims(context.Context, *GetClaimsRequest) (*GetClaimsResponse, error)
-	/// Get token wrapped for the specific smart contract address both in L1 and L2
+	/// Verify the merkle proof of the specific deposit against an exit root
+	VerifyProof(context.Context, *VerifyProofRequest) (*VerifyProofResponse, error)
+	/// Get the deposit and claim volumes, the outstanding amounts and the locked value of every token in every network
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	/// Get token wrapped for the specific smart contract address in the destination network
 	GetTokenWrapped(context.Context, *GetTokenWrappedRequest) (*GetTokenWrappedResponse, error)
+	/// Get the original token and the metadata of a wrapped token address in a network
+	GetTokenWrappedByAddress(context.Context, *GetTokenWrappedByAddressRequest) (*GetTokenWrappedResponse, error)
+	/// Get the wrapped tokens both in L1 and L2
+	ListTokenWrapped(context.Context, *ListTokenWrappedRequest) (*ListTokenWrappedResponse, error)
 	mustEmbedUnimplementedBridgeServiceServer()
 }
 
@@ -129,18 +295,51 @@ func (UnimplementedBridgeServiceServer) CheckAPI(context.Context, *CheckAPIReque
 func (UnimplementedBridgeServiceServer) GetBridges(context.Context, *GetBridgesRequest) (*GetBridgesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBridges not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetBridgesBySender(context.Context, *GetBridgesBySenderRequest) (*GetBridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgesBySender not implemented")
+}
+func (UnimplementedBridgeServiceServer) SubscribeDepositStatus(*SubscribeDepositStatusRequest, BridgeService_SubscribeDepositStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeDepositStatus not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetProofs(context.Context, *GetProofsRequest) (*GetProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofs not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetBridge(context.Context, *GetBridgeRequest) (*GetBridgeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBridge not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetDepositTimeline(context.Context, *GetDepositTimelineRequest) (*GetDepositTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositTimeline not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetLatencySummary(context.Context, *GetLatencySummaryRequest) (*GetLatencySummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatencySummary not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetBridgeByTxHash(context.Context, *GetBridgeByTxHashRequest) (*GetBridgeByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeByTxHash not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetClaimByTxHash(context.Context, *GetClaimByTxHashRequest) (*GetClaimByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimByTxHash not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetClaims(context.Context, *GetClaimsRequest) (*GetClaimsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClaims not implemented")
 }
+func (UnimplementedBridgeServiceServer) VerifyProof(context.Context, *VerifyProofRequest) (*VerifyProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
+}
+func (UnimplementedBridgeServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
 func (UnimplementedBridgeServiceServer) GetTokenWrapped(context.Context, *GetTokenWrappedRequest) (*GetTokenWrappedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTokenWrapped not implemented")
 }
+func (UnimplementedBridgeServiceServer) GetTokenWrappedByAddress(context.Context, *GetTokenWrappedByAddressRequest) (*GetTokenWrappedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenWrappedByAddress not implemented")
+}
+func (UnimplementedBridgeServiceServer) ListTokenWrapped(context.Context, *ListTokenWrappedRequest) (*ListTokenWrappedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokenWrapped not implemented")
+}
 func (UnimplementedBridgeServiceServer) mustEmbedUnimplementedBridgeServiceServer() {}
 
 // UnsafeBridgeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -190,6 +389,45 @@ func _BridgeService_GetBridges_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetBridgesBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBridgesBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetBridgesBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetBridgesBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetBridgesBySender(ctx, req.(*GetBridgesBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_SubscribeDepositStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeDepositStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BridgeServiceServer).SubscribeDepositStatus(m, &bridgeServiceSubscribeDepositStatusServer{stream})
+}
+
+type BridgeService_SubscribeDepositStatusServer interface {
+	Send(*DepositStatusEvent) error
+	grpc.ServerStream
+}
+
+type bridgeServiceSubscribeDepositStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *bridgeServiceSubscribeDepositStatusServer) Send(m *DepositStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BridgeService_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProofRequest)
 	if err := dec(in); err != nil {
@@ -208,6 +446,24 @@ func _BridgeService_GetProof_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetProofs(ctx, req.(*GetProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBridgeRequest)
 	if err := dec(in); err != nil {
@@ -226,6 +482,78 @@ func _BridgeService_GetBridge_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetDepositTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetDepositTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetDepositTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetDepositTimeline(ctx, req.(*GetDepositTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetLatencySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatencySummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetLatencySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetLatencySummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetLatencySummary(ctx, req.(*GetLatencySummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetBridgeByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBridgeByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetBridgeByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetBridgeByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetBridgeByTxHash(ctx, req.(*GetBridgeByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetClaimByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClaimByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetClaimByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetClaimByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetClaimByTxHash(ctx, req.(*GetClaimByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetClaimsRequest)
 	if err := dec(in); err != nil {
@@ -244,6 +572,42 @@ func _BridgeService_GetClaims_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).VerifyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/VerifyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).VerifyProof(ctx, req.(*VerifyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BridgeService_GetTokenWrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTokenWrappedRequest)
 	if err := dec(in); err != nil {
@@ -262,6 +626,42 @@ func _BridgeService_GetTokenWrapped_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BridgeService_GetTokenWrappedByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenWrappedByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).GetTokenWrappedByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/GetTokenWrappedByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).GetTokenWrappedByAddress(ctx, req.(*GetTokenWrappedByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BridgeService_ListTokenWrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokenWrappedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BridgeServiceServer).ListTokenWrapped(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.BridgeService/ListTokenWrapped",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BridgeServiceServer).ListTokenWrapped(ctx, req.(*ListTokenWrappedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BridgeService_ServiceDesc is the grpc.ServiceDesc for BridgeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,22 +677,462 @@ var BridgeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBridges",
 			Handler:    _BridgeService_GetBridges_Handler,
 		},
+		{
+			MethodName: "GetBridgesBySender",
+			Handler:    _BridgeService_GetBridgesBySender_Handler,
+		},
 		{
 			MethodName: "GetProof",
 			Handler:    _BridgeService_GetProof_Handler,
 		},
+		{
+			MethodName: "GetProofs",
+			Handler:    _BridgeService_GetProofs_Handler,
+		},
 		{
 			MethodName: "GetBridge",
 			Handler:    _BridgeService_GetBridge_Handler,
 		},
+		{
+			MethodName: "GetDepositTimeline",
+			Handler:    _BridgeService_GetDepositTimeline_Handler,
+		},
+		{
+			MethodName: "GetLatencySummary",
+			Handler:    _BridgeService_GetLatencySummary_Handler,
+		},
+		{
+			MethodName: "GetBridgeByTxHash",
+			Handler:    _BridgeService_GetBridgeByTxHash_Handler,
+		},
+		{
+			MethodName: "GetClaimByTxHash",
+			Handler:    _BridgeService_GetClaimByTxHash_Handler,
+		},
 		{
 			MethodName: "GetClaims",
 			Handler:    _BridgeService_GetClaims_Handler,
 		},
+		{
+			MethodName: "VerifyProof",
+			Handler:    _BridgeService_VerifyProof_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _BridgeService_GetStats_Handler,
+		},
 		{
 			MethodName: "GetTokenWrapped",
 			Handler:    _BridgeService_GetTokenWrapped_Handler,
 		},
+		{
+			MethodName: "GetTokenWrappedByAddress",
+			Handler:    _BridgeService_GetTokenWrappedByAddress_Handler,
+		},
+		{
+			MethodName: "ListTokenWrapped",
+			Handler:    _BridgeService_ListTokenWrapped_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeDepositStatus",
+			Handler:       _BridgeService_SubscribeDepositStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "query.proto",
+}
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	/// Register an L2 network and start syncing it. Its exit roots aren't verified on L1, so the proofs of its deposits
+	/// are rejected
+	RegisterNetwork(ctx context.Context, in *RegisterNetworkRequest, opts ...grpc.CallOption) (*RegisterNetworkResponse, error)
+	/// Stop syncing an L2 network registered at runtime
+	DeregisterNetwork(ctx context.Context, in *DeregisterNetworkRequest, opts ...grpc.CallOption) (*DeregisterNetworkResponse, error)
+	/// Get the L2 networks registered at runtime
+	GetNetworks(ctx context.Context, in *GetNetworksRequest, opts ...grpc.CallOption) (*GetNetworksResponse, error)
+	/// Get the stats of the merkle proof cache
+	GetProofCacheStats(ctx context.Context, in *GetProofCacheStatsRequest, opts ...grpc.CallOption) (*GetProofCacheStatsResponse, error)
+	/// Subscribe a url to the events of the deposits to an address
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	/// Remove a webhook subscription with its pending deliveries
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	/// Get the webhook subscriptions
+	GetWebhookSubscriptions(ctx context.Context, in *GetWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*GetWebhookSubscriptionsResponse, error)
+	/// Get the webhook deliveries which failed all the attempts
+	GetWebhookDeadLetters(ctx context.Context, in *GetWebhookDeadLettersRequest, opts ...grpc.CallOption) (*GetWebhookDeadLettersResponse, error)
+	/// Send again the webhook deliveries which failed all the attempts
+	ReplayWebhookDeadLetters(ctx context.Context, in *ReplayWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ReplayWebhookDeadLettersResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) RegisterNetwork(ctx context.Context, in *RegisterNetworkRequest, opts ...grpc.CallOption) (*RegisterNetworkResponse, error) {
+	out := new(RegisterNetworkResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/RegisterNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeregisterNetwork(ctx context.Context, in *DeregisterNetworkRequest, opts ...grpc.CallOption) (*DeregisterNetworkResponse, error) {
+	out := new(DeregisterNetworkResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/DeregisterNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetNetworks(ctx context.Context, in *GetNetworksRequest, opts ...grpc.CallOption) (*GetNetworksResponse, error) {
+	out := new(GetNetworksResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/GetNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetProofCacheStats(ctx context.Context, in *GetProofCacheStatsRequest, opts ...grpc.CallOption) (*GetProofCacheStatsResponse, error) {
+	out := new(GetProofCacheStatsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/GetProofCacheStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/CreateWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/DeleteWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWebhookSubscriptions(ctx context.Context, in *GetWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*GetWebhookSubscriptionsResponse, error) {
+	out := new(GetWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/GetWebhookSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetWebhookDeadLetters(ctx context.Context, in *GetWebhookDeadLettersRequest, opts ...grpc.CallOption) (*GetWebhookDeadLettersResponse, error) {
+	out := new(GetWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/GetWebhookDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReplayWebhookDeadLetters(ctx context.Context, in *ReplayWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ReplayWebhookDeadLettersResponse, error) {
+	out := new(ReplayWebhookDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/bridge.v1.AdminService/ReplayWebhookDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	/// Register an L2 network and start syncing it. Its exit roots aren't verified on L1, so the proofs of its deposits
+	/// are rejected
+	RegisterNetwork(context.Context, *RegisterNetworkRequest) (*RegisterNetworkResponse, error)
+	/// Stop syncing an L2 network registered at runtime
+	DeregisterNetwork(context.Context, *DeregisterNetworkRequest) (*DeregisterNetworkResponse, error)
+	/// Get the L2 networks registered at runtime
+	GetNetworks(context.Context, *GetNetworksRequest) (*GetNetworksResponse, error)
+	/// Get the stats of the merkle proof cache
+	GetProofCacheStats(context.Context, *GetProofCacheStatsRequest) (*GetProofCacheStatsResponse, error)
+	/// Subscribe a url to the events of the deposits to an address
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	/// Remove a webhook subscription with its pending deliveries
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	/// Get the webhook subscriptions
+	GetWebhookSubscriptions(context.Context, *GetWebhookSubscriptionsRequest) (*GetWebhookSubscriptionsResponse, error)
+	/// Get the webhook deliveries which failed all the attempts
+	GetWebhookDeadLetters(context.Context, *GetWebhookDeadLettersRequest) (*GetWebhookDeadLettersResponse, error)
+	/// Send again the webhook deliveries which failed all the attempts
+	ReplayWebhookDeadLetters(context.Context, *ReplayWebhookDeadLettersRequest) (*ReplayWebhookDeadLettersResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) RegisterNetwork(context.Context, *RegisterNetworkRequest) (*RegisterNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNetwork not implemented")
+}
+func (UnimplementedAdminServiceServer) DeregisterNetwork(context.Context, *DeregisterNetworkRequest) (*DeregisterNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterNetwork not implemented")
+}
+func (UnimplementedAdminServiceServer) GetNetworks(context.Context, *GetNetworksRequest) (*GetNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworks not implemented")
+}
+func (UnimplementedAdminServiceServer) GetProofCacheStats(context.Context, *GetProofCacheStatsRequest) (*GetProofCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProofCacheStats not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWebhookSubscriptions(context.Context, *GetWebhookSubscriptionsRequest) (*GetWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookSubscriptions not implemented")
+}
+func (UnimplementedAdminServiceServer) GetWebhookDeadLetters(context.Context, *GetWebhookDeadLettersRequest) (*GetWebhookDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeadLetters not implemented")
+}
+func (UnimplementedAdminServiceServer) ReplayWebhookDeadLetters(context.Context, *ReplayWebhookDeadLettersRequest) (*ReplayWebhookDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookDeadLetters not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_RegisterNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RegisterNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/RegisterNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RegisterNetwork(ctx, req.(*RegisterNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeregisterNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeregisterNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/DeregisterNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeregisterNetwork(ctx, req.(*DeregisterNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/GetNetworks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetNetworks(ctx, req.(*GetNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetProofCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProofCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/GetProofCacheStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProofCacheStats(ctx, req.(*GetProofCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/CreateWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/DeleteWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/GetWebhookSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWebhookSubscriptions(ctx, req.(*GetWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetWebhookDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/GetWebhookDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetWebhookDeadLetters(ctx, req.(*GetWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReplayWebhookDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReplayWebhookDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bridge.v1.AdminService/ReplayWebhookDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReplayWebhookDeadLetters(ctx, req.(*ReplayWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bridge.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterNetwork",
+			Handler:    _AdminService_RegisterNetwork_Handler,
+		},
+		{
+			MethodName: "DeregisterNetwork",
+			Handler:    _AdminService_DeregisterNetwork_Handler,
+		},
+		{
+			MethodName: "GetNetworks",
+			Handler:    _AdminService_GetNetworks_Handler,
+		},
+		{
+			MethodName: "GetProofCacheStats",
+			Handler:    _AdminService_GetProofCacheStats_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _AdminService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _AdminService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "GetWebhookSubscriptions",
+			Handler:    _AdminService_GetWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeadLetters",
+			Handler:    _AdminService_GetWebhookDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookDeadLetters",
+			Handler:    _AdminService_ReplayWebhookDeadLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query.proto",
@@ -0,0 +1,183 @@
+package bridgectrl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// DepositKey identifies a deposit by its network and deposit count.
+type DepositKey struct {
+	NetworkID    uint
+	DepositCount uint
+}
+
+// ProofResult is the merkle proof of a deposit requested in a batch, or the error which prevented generating it.
+type ProofResult struct {
+	Proof          *Proof
+	GlobalExitRoot *etherman.GlobalExitRoot
+	Err            error
+}
+
+// nodeCache keeps the nodes read from the store, so the proofs generated together read the nodes near the root once.
+// The nodes are content addressed, so the cache is shared by the trees of all the networks.
+type nodeCache struct {
+	store nodeReader
+	nodes map[[KeyLen]byte][][]byte
+}
+
+func (c *nodeCache) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
+	var k [KeyLen]byte
+	copy(k[:], key)
+	if value, found := c.nodes[k]; found {
+		return value, nil
+	}
+	value, err := c.store.Get(ctx, key, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	c.nodes[k] = value
+	return value, nil
+}
+
+// batchExitRoot is the exit root of a network resolved once for all the deposits of the network in a batch.
+type batchExitRoot struct {
+	globalExitRoot *etherman.GlobalExitRoot
+	localExitRoot  [KeyLen]byte
+	leaves         []*etherman.RollupExitLeaf
+	depositCnt     uint
+	err            error
+}
+
+// GetProofs returns the merkle proofs of a list of deposits. If ger is nil, the proofs are generated against the
+// latest global exit root of each network, as in GetClaim, otherwise against the synced global exit root with the
+// hash, as in GetClaimByExitRoot. All the proofs and the global exit root are read from the same snapshot, and the
+// global exit roots, the local exit roots and the nodes of the trees are read once for all the deposits. The error
+// of each deposit is returned in its result.
+func (bt *BridgeController) GetProofs(ctx context.Context, deposits []DepositKey, ger *common.Hash) ([]ProofResult, error) {
+	var (
+		results  = make([]ProofResult, len(deposits))
+		networks []uint
+		indexes  = make(map[uint][]int)
+	)
+	for i, deposit := range deposits {
+		if _, found := indexes[deposit.NetworkID]; !found {
+			networks = append(networks, deposit.NetworkID)
+		}
+		indexes[deposit.NetworkID] = append(indexes[deposit.NetworkID], i)
+	}
+
+	err := bt.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var (
+			globalExitRoot *etherman.GlobalExitRoot
+			latest         = make(map[bool]*etherman.GlobalExitRoot)
+			nodes          = &nodeCache{store: bt.mtStore, nodes: make(map[[KeyLen]byte][][]byte)}
+		)
+		if ger != nil {
+			var err error
+			globalExitRoot, err = bt.storage.GetExitRootByGlobalExitRoot(ctx, *ger, dbTx)
+			if err != nil {
+				return err
+			}
+		}
+		for _, networkID := range networks {
+			bt.getNetworkProofs(ctx, networkID, deposits, indexes[networkID], results, globalExitRoot, latest, nodes, dbTx)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// getNetworkProofs fills the results of the deposits of the network at the indexes, holding the read lock of its tree.
+func (bt *BridgeController) getNetworkProofs(ctx context.Context, networkID uint, deposits []DepositKey, indexes []int, results []ProofResult,
+	globalExitRoot *etherman.GlobalExitRoot, latest map[bool]*etherman.GlobalExitRoot, nodes *nodeCache, dbTx pgx.Tx) {
+	notIncluded := gerror.ErrDepositNotIncluded
+	if globalExitRoot == nil {
+		notIncluded = gerror.ErrDepositNotSynced
+	}
+	mt, tID, err := bt.exitTree(networkID)
+	if err != nil {
+		for _, i := range indexes {
+			results[i].Err = err
+		}
+		return
+	}
+
+	mt.lock.RLock()
+	defer mt.lock.RUnlock()
+	exitRoot := bt.batchExitRoot(ctx, networkID, mt, tID, globalExitRoot, latest, notIncluded, dbTx)
+	for _, i := range indexes {
+		if exitRoot.err != nil {
+			results[i].Err = exitRoot.err
+			continue
+		}
+		index := deposits[i].DepositCount
+		if exitRoot.depositCnt <= index {
+			results[i].Err = notIncluded
+			continue
+		}
+		siblings, err := bt.getSiblings(ctx, mt, nodes, networkID, index, exitRoot.localExitRoot, exitRoot.depositCnt, dbTx)
+		if err != nil {
+			results[i].Err = fmt.Errorf("getting the proof failed, error: %v, index: %d, root: %v", err, index, exitRoot.localExitRoot)
+			continue
+		}
+		proof := &Proof{
+			MerkleProof: siblings,
+		}
+		if exitRoot.leaves != nil {
+			proof.RollupIndex = rollupLeaf(exitRoot.leaves, networkID).RollupIndex
+			_, proof.RollupMerkleProof = rollupExitTree(exitRoot.leaves, proof.RollupIndex, bt.height)
+		}
+		results[i].Proof = proof
+		results[i].GlobalExitRoot = exitRoot.globalExitRoot
+	}
+}
+
+// batchExitRoot resolves the exit root of the network in the global exit root. If globalExitRoot is nil, the latest
+// global exit root of the network is used, the latest ones are kept in latest indexed by mainnet or rollup.
+func (bt *BridgeController) batchExitRoot(ctx context.Context, networkID uint, mt *MerkleTree, tID uint8, globalExitRoot *etherman.GlobalExitRoot,
+	latest map[bool]*etherman.GlobalExitRoot, notIncluded error, dbTx pgx.Tx) *batchExitRoot {
+	var err error
+	if globalExitRoot == nil {
+		mainnet := networkID == MainNetworkID
+		globalExitRoot = latest[mainnet]
+		if globalExitRoot == nil {
+			if mainnet {
+				globalExitRoot, err = bt.storage.GetLatestTrustedExitRoot(ctx, dbTx)
+			} else {
+				globalExitRoot, err = bt.storage.GetLatestL1SyncedExitRoot(ctx, dbTx)
+			}
+			if err != nil {
+				return &batchExitRoot{err: fmt.Errorf("getting the last GER failed, error: %v", err)}
+			}
+			latest[mainnet] = globalExitRoot
+		}
+	}
+
+	exitRoot := &batchExitRoot{globalExitRoot: globalExitRoot}
+	exitRoot.localExitRoot, exitRoot.leaves, err = bt.localExitRoot(ctx, networkID, tID, globalExitRoot, dbTx)
+	if err != nil {
+		exitRoot.err = notIncluded
+		if err != gerror.ErrStorageNotFound {
+			exitRoot.err = fmt.Errorf("getting the local exit root failed, error: %v", err)
+		} else if err = bt.unverifiedRollupError(ctx, networkID, tID, dbTx); err != nil {
+			exitRoot.err = err
+		}
+		return exitRoot
+	}
+	exitRoot.depositCnt, err = mt.store.GetDepositCountByRoot(ctx, exitRoot.localExitRoot[:], tID, dbTx)
+	if err != nil {
+		exitRoot.err = notIncluded
+		if err != gerror.ErrStorageNotFound {
+			exitRoot.err = fmt.Errorf("getting deposit count from the MT root failed, error: %v, root: %v, network: %d", err, exitRoot.localExitRoot, tID)
+		}
+	}
+	return exitRoot
+}
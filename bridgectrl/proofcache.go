@@ -0,0 +1,126 @@
+package bridgectrl
+
+import (
+	"container/list"
+	"sync"
+)
+
+// proofCacheKey identifies the merkle proof of a deposit against an exit root of its network.
+type proofCacheKey struct {
+	networkID uint
+	index     uint
+	root      [KeyLen]byte
+}
+
+type proofCacheEntry struct {
+	key proofCacheKey
+	// depositCnt is the number of leaves of the tree at the root, it tells which entries are removed by a reorg
+	depositCnt uint
+	siblings   [][KeyLen]byte
+}
+
+// ProofCacheStats are the counters of the proof cache since the bridge controller was created.
+type ProofCacheStats struct {
+	Size      int
+	Entries   int
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRate returns the ratio of the lookups served from the cache.
+func (s ProofCacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// proofCache is a bounded LRU cache of the siblings of the deposits. The siblings of a deposit against a root never
+// change, so the entries are only removed when the cache is full or when a reorg removes their root.
+type proofCache struct {
+	lock    sync.Mutex
+	size    int
+	entries map[proofCacheKey]*list.Element
+	lru     *list.List
+	stats   ProofCacheStats
+}
+
+// newProofCache creates a proof cache with the maximum number of entries. A size of 0 disables the cache.
+func newProofCache(size int) *proofCache {
+	return &proofCache{
+		size:    size,
+		entries: make(map[proofCacheKey]*list.Element),
+		lru:     list.New(),
+		stats:   ProofCacheStats{Size: size},
+	}
+}
+
+func (c *proofCache) get(key proofCacheKey) ([][KeyLen]byte, bool) {
+	if c.size == 0 {
+		return nil, false
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	elem, found := c.entries[key]
+	if !found {
+		c.stats.Misses++
+		return nil, false
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*proofCacheEntry).siblings, true
+}
+
+func (c *proofCache) add(key proofCacheKey, depositCnt uint, siblings [][KeyLen]byte) {
+	if c.size == 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, found := c.entries[key]; found {
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&proofCacheEntry{key: key, depositCnt: depositCnt, siblings: siblings})
+	if c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+		c.stats.Evictions++
+	}
+}
+
+// reorg removes the entries of the network whose roots have more leaves than depositCnt, as they are removed from
+// the tree by the reorg.
+func (c *proofCache) reorg(networkID uint, depositCnt uint) {
+	if c.size == 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*proofCacheEntry)
+		if entry.key.networkID == networkID && entry.depositCnt > depositCnt {
+			c.remove(elem)
+		}
+		elem = next
+	}
+}
+
+// clear removes all the entries of the network.
+func (c *proofCache) clear(networkID uint) {
+	c.reorg(networkID, 0)
+}
+
+func (c *proofCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*proofCacheEntry).key)
+}
+
+func (c *proofCache) getStats() ProofCacheStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stats := c.stats
+	stats.Entries = c.lru.Len()
+	return stats
+}
@@ -0,0 +1,53 @@
+package bridgectrl
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProofCache(t *testing.T) {
+	cache := newProofCache(3)
+	key := func(networkID uint, index uint, depositCnt int64) proofCacheKey {
+		return proofCacheKey{networkID: networkID, index: index, root: common.BigToHash(big.NewInt(depositCnt))}
+	}
+	siblings := [][KeyLen]byte{common.HexToHash("0x01"), common.HexToHash("0x02")}
+
+	_, found := cache.get(key(0, 0, 1))
+	require.False(t, found)
+	cache.add(key(0, 0, 1), 1, siblings)
+	cache.add(key(0, 0, 2), 2, siblings)
+	cache.add(key(1, 0, 2), 2, siblings)
+	cached, found := cache.get(key(0, 0, 1))
+	require.True(t, found)
+	assert.Equal(t, siblings, cached)
+
+	// The least recently used entry is evicted
+	cache.add(key(0, 1, 3), 3, siblings)
+	_, found = cache.get(key(0, 0, 2))
+	require.False(t, found)
+	_, found = cache.get(key(0, 0, 1))
+	require.True(t, found)
+
+	// A reorg only removes the entries of the network with more leaves than the reorged deposit count
+	cache.reorg(0, 2)
+	_, found = cache.get(key(0, 1, 3))
+	require.False(t, found)
+	_, found = cache.get(key(0, 0, 1))
+	require.True(t, found)
+	_, found = cache.get(key(1, 0, 2))
+	require.True(t, found)
+
+	stats := cache.getStats()
+	assert.Equal(t, ProofCacheStats{Size: 3, Entries: 2, Hits: 4, Misses: 3, Evictions: 1}, stats)
+	assert.Equal(t, float64(4)/7, stats.HitRate())
+
+	// A disabled cache never stores the proofs
+	cache = newProofCache(0)
+	cache.add(key(0, 0, 1), 1, siblings)
+	_, found = cache.get(key(0, 0, 1))
+	require.False(t, found)
+}
@@ -0,0 +1,63 @@
+package bridgectrl
+
+import (
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+)
+
+// rollupIndex returns the index of the leaf of the rollup in the rollup exit tree. The exit trees of the rollups
+// follow the one of the mainnet and their indexes are never reused, so it is the index of the exit tree minus one.
+func rollupIndex(tID uint8) uint {
+	return uint(tID) - 1
+}
+
+// rollupLeaf returns the leaf of the network in the rollup exit tree, or nil if the network isn't included.
+func rollupLeaf(leaves []*etherman.RollupExitLeaf, networkID uint) *etherman.RollupExitLeaf {
+	for _, leaf := range leaves {
+		if leaf.NetworkID == networkID {
+			return leaf
+		}
+	}
+	return nil
+}
+
+// rollupExitTree computes the root of the rollup exit tree, whose leaves are the verified local exit roots of the
+// rollups, and the siblings of the leaf with the index. The empty leaves are zero.
+func rollupExitTree(leaves []*etherman.RollupExitLeaf, index uint, height uint8) ([KeyLen]byte, [][KeyLen]byte) {
+	var (
+		level    = make(map[uint][KeyLen]byte, len(leaves))
+		siblings = make([][KeyLen]byte, 0, height)
+	)
+	for _, leaf := range leaves {
+		level[leaf.RollupIndex] = leaf.LocalExitRoot
+	}
+	for h := uint8(0); h < height; h++ {
+		sibling, found := level[index^1]
+		if !found {
+			sibling = zeroHashes[h]
+		}
+		siblings = append(siblings, sibling)
+
+		parents := make(map[uint][KeyLen]byte, (len(level)+1)/2) //nolint:gomnd
+		for i := range level {
+			if _, found := parents[i>>1]; found {
+				continue
+			}
+			left, found := level[i&^1]
+			if !found {
+				left = zeroHashes[h]
+			}
+			right, found := level[i|1]
+			if !found {
+				right = zeroHashes[h]
+			}
+			parents[i>>1] = hash(left, right)
+		}
+		level = parents
+		index >>= 1
+	}
+	root, found := level[0]
+	if !found {
+		root = zeroHashes[height]
+	}
+	return root, siblings
+}
@@ -0,0 +1,37 @@
+package bridgectrl
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollupExitTree(t *testing.T) {
+	root, _ := rollupExitTree(nil, 0, 32)
+	assert.Equal(t, zeroHashes[32], root)
+
+	leaves := []*etherman.RollupExitLeaf{
+		{NetworkID: 1, RollupIndex: 0, LocalExitRoot: common.HexToHash("0x1")},
+		{NetworkID: 1000, RollupIndex: 1, LocalExitRoot: common.HexToHash("0x3e8")},
+		{NetworkID: 2, RollupIndex: 4, LocalExitRoot: common.HexToHash("0x2")},
+	}
+	// The root is computed independently from the leaves 0, 1 and 4, the leaves 2 and 3 are empty
+	left := hash(common.HexToHash("0x1"), common.HexToHash("0x3e8"))
+	left = hash(left, zeroHashes[1])
+	right := hash(common.HexToHash("0x2"), zeroHashes[0])
+	right = hash(right, zeroHashes[1])
+	expectedRoot := CalculateRoot(hash(left, right), 0, zeroHashes[3:32])
+	for _, leaf := range leaves {
+		root, siblings := rollupExitTree(leaves, leaf.RollupIndex, 32)
+		assert.Len(t, siblings, 32)
+		assert.Equal(t, expectedRoot, root)
+		assert.Equal(t, root, CalculateRoot(leaf.LocalExitRoot, leaf.RollupIndex, siblings))
+	}
+
+	// A single rollup is the leaf 0 of the tree
+	root, siblings := rollupExitTree(leaves[:1], 0, 32)
+	assert.Equal(t, zeroHashes[:32], siblings)
+	assert.NotEqual(t, common.HexToHash("0x1"), common.Hash(root))
+}
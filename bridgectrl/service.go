@@ -3,30 +3,46 @@ package bridgectrl
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
-	defaultPageLimit = 25
-	maxPageLimit     = 100
-	version          = "v1"
+	defaultPageLimit    = 25
+	maxPageLimit        = 100
+	maxProofsPerRequest = 1000
+	maxStatsWindows     = 10
+	version             = "v1"
 )
 
+// defaultStatsWindows are the windows of the volumes of the stats when the request has none.
+var defaultStatsWindows = []time.Duration{24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour}
+
 type bridgeService struct {
 	storage    BridgeServiceStorage
 	bridgeCtrl *BridgeController
+	notifier   *DepositNotifier
+	eta        *latencyEstimator
 	pb.UnimplementedBridgeServiceServer
 }
 
-// NewBridgeService creates new bridge service.
-func NewBridgeService(storage BridgeServiceStorage, bridgeCtrl *BridgeController) pb.BridgeServiceServer {
+// NewBridgeService creates new bridge service. The subscriptions are only served if the notifier is provided.
+func NewBridgeService(storage BridgeServiceStorage, bridgeCtrl *BridgeController, notifier *DepositNotifier) pb.BridgeServiceServer {
 	return &bridgeService{
 		storage:    storage,
 		bridgeCtrl: bridgeCtrl,
+		notifier:   notifier,
+		eta:        newLatencyEstimator(storage),
 	}
 }
 
@@ -37,57 +53,151 @@ func (s *bridgeService) CheckAPI(ctx context.Context, req *pb.CheckAPIRequest) (
 	}, nil
 }
 
-// GetBridges returns bridges for the destination address both in L1 and L2.
+// GetBridges returns the bridges matching the filters of the request both in L1 and L2. The total count is the
+// number of bridges matching the filters. The page starts after the cursor of the request, if provided.
 func (s *bridgeService) GetBridges(ctx context.Context, req *pb.GetBridgesRequest) (*pb.GetBridgesResponse, error) {
-	limit := req.Limit
-	if limit == 0 {
-		limit = defaultPageLimit
-	}
-	if limit > maxPageLimit {
-		limit = maxPageLimit
-	}
-	totalCount, err := s.storage.GetDepositCount(ctx, req.DestAddr, nil)
+	filter, err := depositFilter(req)
 	if err != nil {
 		return nil, err
 	}
-	deposits, err := s.storage.GetDeposits(ctx, req.DestAddr, uint(limit), uint(req.Offset), nil)
+	return s.getBridges(ctx, filter, req.Cursor, req.Offset, req.Limit)
+}
+
+// GetBridgesBySender returns the bridges sent by the address both in L1 and L2. The deposits synced before their
+// sender was stored are only returned once they are backfilled.
+func (s *bridgeService) GetBridgesBySender(ctx context.Context, req *pb.GetBridgesBySenderRequest) (*pb.GetBridgesResponse, error) {
+	if !common.IsHexAddress(req.SenderAddr) {
+		return nil, gerror.ErrInvalidFilter
+	}
+	sender := common.HexToAddress(req.SenderAddr)
+	return s.getBridges(ctx, &etherman.DepositFilter{Sender: &sender}, req.Cursor, req.Offset, req.Limit)
+}
+
+// getBridges returns a page of the bridges matching the filter, with their total count.
+func (s *bridgeService) getBridges(ctx context.Context, filter *etherman.DepositFilter, cursor string, offset uint64, limit uint32) (*pb.GetBridgesResponse, error) {
+	after, err := decodeCursor(cursor)
 	if err != nil {
 		return nil, err
 	}
-
-	var pbDeposits []*pb.Deposit
-	for _, deposit := range deposits {
-		claimTxHash, readyForClaim, err := s.getDepositStatus(ctx, deposit.DepositCount, deposit.NetworkID, deposit.DestinationNetwork)
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	var (
+		totalCount uint64
+		pbDeposits []*pb.Deposit
+		nextCursor string
+	)
+	err = s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		totalCount, err = s.storage.GetDepositCount(ctx, filter, dbTx)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		pbDeposits = append(
-			pbDeposits, &pb.Deposit{
-				LeafType:      uint32(deposit.LeafType),
-				OrigNet:       uint32(deposit.OriginalNetwork),
-				OrigAddr:      deposit.OriginalAddress.Hex(),
-				Amount:        deposit.Amount.String(),
-				DestNet:       uint32(deposit.DestinationNetwork),
-				DestAddr:      deposit.DestinationAddress.Hex(),
-				BlockNum:      deposit.BlockNumber,
-				DepositCnt:    uint64(deposit.DepositCount),
-				NetworkId:     uint32(deposit.NetworkID),
-				TxHash:        deposit.TxHash.String(),
-				ClaimTxHash:   claimTxHash,
-				Metadata:      "0x" + hex.EncodeToString(deposit.Metadata),
-				ReadyForClaim: readyForClaim,
-			},
-		)
+		// One more deposit is read to know if there is a next page
+		deposits, err := s.storage.GetDeposits(ctx, filter, after, uint(limit)+1, uint(offset), dbTx)
+		if err != nil {
+			return err
+		}
+		if len(deposits) > int(limit) {
+			deposits = deposits[:limit]
+			last := deposits[limit-1]
+			nextCursor = encodeCursor(&etherman.Cursor{BlockID: last.BlockID, NetworkID: last.NetworkID, Index: last.DepositCount})
+		}
+
+		for _, deposit := range deposits {
+			claimTxHash, readyForClaim, err := s.getDepositStatus(ctx, deposit, dbTx)
+			if err != nil {
+				return err
+			}
+			pbDeposit := depositToPb(deposit, claimTxHash, readyForClaim)
+			if pbDeposit.Eta, err = s.depositEta(ctx, deposit, claimTxHash, readyForClaim); err != nil {
+				return err
+			}
+			pbDeposits = append(pbDeposits, pbDeposit)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return &pb.GetBridgesResponse{
-		Deposits: pbDeposits,
-		TotalCnt: totalCount,
+		Deposits:   pbDeposits,
+		TotalCnt:   totalCount,
+		NextCursor: nextCursor,
 	}, nil
 }
 
-// GetClaims returns claims for the specific smart contract address both in L1 and L2.
+// depositFilter returns the deposit filter of the request.
+func depositFilter(req *pb.GetBridgesRequest) (*etherman.DepositFilter, error) {
+	filter := &etherman.DepositFilter{}
+	if req.DestAddr != "" {
+		if !common.IsHexAddress(req.DestAddr) {
+			return nil, gerror.ErrInvalidFilter
+		}
+		destAddr := common.HexToAddress(req.DestAddr)
+		filter.DestinationAddress = &destAddr
+	}
+	if req.OrigAddr != "" {
+		if !common.IsHexAddress(req.OrigAddr) {
+			return nil, gerror.ErrInvalidFilter
+		}
+		origAddr := common.HexToAddress(req.OrigAddr)
+		filter.OriginalAddress = &origAddr
+	}
+	if req.NetId != nil {
+		networkID := uint(*req.NetId)
+		filter.NetworkID = &networkID
+	}
+	if req.DestNet != nil {
+		destNet := uint(*req.DestNet)
+		filter.DestinationNetwork = &destNet
+	}
+	if req.OrigNet != nil {
+		origNet := uint(*req.OrigNet)
+		filter.OriginalNetwork = &origNet
+	}
+	if req.LeafType != nil {
+		if *req.LeafType > math.MaxUint8 {
+			return nil, gerror.ErrInvalidFilter
+		}
+		leafType := uint8(*req.LeafType)
+		filter.LeafType = &leafType
+	}
+	switch req.Status {
+	case pb.DepositStatus_DEPOSIT_STATUS_UNSPECIFIED:
+		filter.Status = etherman.DepositStatusAny
+	case pb.DepositStatus_DEPOSIT_STATUS_PENDING:
+		filter.Status = etherman.DepositStatusPending
+	case pb.DepositStatus_DEPOSIT_STATUS_READY_FOR_CLAIM:
+		filter.Status = etherman.DepositStatusReadyForClaim
+	case pb.DepositStatus_DEPOSIT_STATUS_CLAIMED:
+		filter.Status = etherman.DepositStatusClaimed
+	default:
+		return nil, gerror.ErrInvalidFilter
+	}
+	filter.FromBlock, filter.ToBlock = req.FromBlock, req.ToBlock
+	if req.FromTime != nil {
+		fromTime := time.Unix(*req.FromTime, 0)
+		filter.FromTime = &fromTime
+	}
+	if req.ToTime != nil {
+		toTime := time.Unix(*req.ToTime, 0)
+		filter.ToTime = &toTime
+	}
+	return filter, nil
+}
+
+// GetClaims returns claims for the specific smart contract address both in L1 and L2. The page starts after the
+// cursor of the request, if provided.
 func (s *bridgeService) GetClaims(ctx context.Context, req *pb.GetClaimsRequest) (*pb.GetClaimsResponse, error) {
+	after, err := decodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
 	limit := req.Limit
 	if limit == 0 {
 		limit = defaultPageLimit
@@ -95,145 +205,671 @@ func (s *bridgeService) GetClaims(ctx context.Context, req *pb.GetClaimsRequest)
 	if limit > maxPageLimit {
 		limit = maxPageLimit
 	}
-	totalCount, err := s.storage.GetClaimCount(ctx, req.DestAddr, nil)
+	var (
+		totalCount uint64
+		claims     []*etherman.Claim
+		nextCursor string
+	)
+	err = s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		totalCount, err = s.storage.GetClaimCount(ctx, req.DestAddr, dbTx)
+		if err != nil {
+			return err
+		}
+		// One more claim is read to know if there is a next page
+		claims, err = s.storage.GetClaims(ctx, req.DestAddr, after, uint(limit)+1, uint(req.Offset), dbTx)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	claims, err := s.storage.GetClaims(ctx, req.DestAddr, uint(limit), uint(req.Offset), nil) //nolint:gomnd
-	if err != nil {
-		return nil, err
+	if len(claims) > int(limit) {
+		claims = claims[:limit]
+		last := claims[limit-1]
+		nextCursor = encodeCursor(&etherman.Cursor{BlockID: last.BlockID, NetworkID: last.NetworkID, Index: last.Index})
 	}
 
 	var pbClaims []*pb.Claim
 	for _, claim := range claims {
-		pbClaims = append(pbClaims, &pb.Claim{
-			Index:     uint64(claim.Index),
-			OrigNet:   uint32(claim.OriginalNetwork),
-			OrigAddr:  claim.OriginalAddress.Hex(),
-			Amount:    claim.Amount.String(),
-			NetworkId: uint32(claim.NetworkID),
-			DestAddr:  claim.DestinationAddress.Hex(),
-			BlockNum:  claim.BlockNumber,
-			TxHash:    claim.TxHash.String(),
-		})
+		pbClaims = append(pbClaims, claimToPb(claim))
 	}
 
 	return &pb.GetClaimsResponse{
-		Claims:   pbClaims,
-		TotalCnt: totalCount,
+		Claims:     pbClaims,
+		TotalCnt:   totalCount,
+		NextCursor: nextCursor,
 	}, nil
 }
 
-// GetProof returns the merkle proof for the specific deposit.
+// GetProof returns the merkle proof for the specific deposit. By default the proof is generated against the
+// latest global exit root, but the caller can choose a previous global exit root or mainnet and rollup exit roots.
 func (s *bridgeService) GetProof(ctx context.Context, req *pb.GetProofRequest) (*pb.GetProofResponse, error) {
-	merkleProof, exitRoot, err := s.bridgeCtrl.GetClaim(uint(req.NetId), uint(req.DepositCnt))
+	var (
+		merkleProof *Proof
+		exitRoot    *etherman.GlobalExitRoot
+	)
+	if (req.MainnetExitRoot != "" || req.RollupExitRoot != "") && (req.MainnetExitRoot == "" || req.RollupExitRoot == "") {
+		return nil, gerror.ErrMissingExitRoot
+	}
+	err := s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		switch {
+		case req.GlobalExitRoot != "":
+			exitRoot, err = s.bridgeCtrl.storage.GetExitRootByGlobalExitRoot(ctx, common.HexToHash(req.GlobalExitRoot), dbTx)
+			if err != nil {
+				return err
+			}
+			merkleProof, err = s.bridgeCtrl.getClaimByExitRoot(ctx, uint(req.NetId), uint(req.DepositCnt), exitRoot, dbTx)
+		case req.MainnetExitRoot != "":
+			// The pair of exit roots must be of a global exit root synced from the chain
+			mainnetExitRoot, rollupExitRoot := common.HexToHash(req.MainnetExitRoot), common.HexToHash(req.RollupExitRoot)
+			exitRoot, err = s.bridgeCtrl.storage.GetExitRootByGlobalExitRoot(ctx, hash(mainnetExitRoot, rollupExitRoot), dbTx)
+			if err != nil {
+				return err
+			}
+			merkleProof, err = s.bridgeCtrl.getClaimByExitRoot(ctx, uint(req.NetId), uint(req.DepositCnt), exitRoot, dbTx)
+		default:
+			merkleProof, exitRoot, err = s.bridgeCtrl.getClaim(ctx, uint(req.NetId), uint(req.DepositCnt), dbTx)
+		}
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var proof []string
-	for i := 0; i < len(merkleProof); i++ {
-		proof = append(proof, "0x"+hex.EncodeToString(merkleProof[i][:]))
+	return &pb.GetProofResponse{
+		Proof: proofToPb(merkleProof, exitRoot),
+	}, nil
+}
+
+// GetProofs returns the merkle proofs for a list of deposits. All the proofs are generated against the same global
+// exit roots, and the error of each deposit is returned in its result.
+func (s *bridgeService) GetProofs(ctx context.Context, req *pb.GetProofsRequest) (*pb.GetProofsResponse, error) {
+	if len(req.Deposits) > maxProofsPerRequest {
+		return nil, fmt.Errorf("too many deposits, the limit is %d", maxProofsPerRequest)
+	}
+	var ger *common.Hash
+	if req.GlobalExitRoot != "" {
+		globalExitRoot := common.HexToHash(req.GlobalExitRoot)
+		ger = &globalExitRoot
 	}
 
-	return &pb.GetProofResponse{
-		Proof: &pb.Proof{
-			MerkleProof:    proof,
-			MainExitRoot:   exitRoot.ExitRoots[0].Hex(),
-			RollupExitRoot: exitRoot.ExitRoots[1].Hex(),
-		},
+	deposits := make([]DepositKey, 0, len(req.Deposits))
+	for _, deposit := range req.Deposits {
+		deposits = append(deposits, DepositKey{NetworkID: uint(deposit.NetId), DepositCount: uint(deposit.DepositCnt)})
+	}
+	results, err := s.bridgeCtrl.GetProofs(ctx, deposits, ger)
+	if err != nil {
+		return nil, err
+	}
+
+	pbResults := make([]*pb.ProofResult, 0, len(results))
+	for i, result := range results {
+		pbResult := &pb.ProofResult{
+			NetId:      req.Deposits[i].NetId,
+			DepositCnt: req.Deposits[i].DepositCnt,
+		}
+		if result.Err != nil {
+			pbResult.Error = result.Err.Error()
+		} else {
+			pbResult.Proof = proofToPb(result.Proof, result.GlobalExitRoot)
+		}
+		pbResults = append(pbResults, pbResult)
+	}
+	return &pb.GetProofsResponse{
+		Proofs: pbResults,
 	}, nil
 }
 
+func proofToPb(merkleProof *Proof, exitRoot *etherman.GlobalExitRoot) *pb.Proof {
+	var proof, rollupProof []string
+	for i := 0; i < len(merkleProof.MerkleProof); i++ {
+		proof = append(proof, "0x"+hex.EncodeToString(merkleProof.MerkleProof[i][:]))
+	}
+	for i := 0; i < len(merkleProof.RollupMerkleProof); i++ {
+		rollupProof = append(rollupProof, "0x"+hex.EncodeToString(merkleProof.RollupMerkleProof[i][:]))
+	}
+	return &pb.Proof{
+		MerkleProof:       proof,
+		MainExitRoot:      exitRoot.ExitRoots[0].Hex(),
+		RollupExitRoot:    exitRoot.ExitRoots[1].Hex(),
+		RollupMerkleProof: rollupProof,
+		RollupIndex:       uint32(merkleProof.RollupIndex),
+	}
+}
+
 // GetDepositStatus returns the claim status whether it is able to send a claim transaction or not.
 func (s *bridgeService) GetBridge(ctx context.Context, req *pb.GetBridgeRequest) (*pb.GetBridgeResponse, error) {
-	deposit, err := s.storage.GetDeposit(ctx, uint(req.DepositCnt), uint(req.NetId), nil)
+	var (
+		deposit       *etherman.Deposit
+		claimTxHash   string
+		readyForClaim bool
+	)
+	err := s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		deposit, err = s.storage.GetDeposit(ctx, uint(req.DepositCnt), uint(req.NetId), dbTx)
+		if err != nil {
+			return err
+		}
+		claimTxHash, readyForClaim, err = s.getDepositStatus(ctx, deposit, dbTx)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	claimTxHash, readyForClaim, err := s.getDepositStatus(ctx, uint(req.DepositCnt), uint(req.NetId), deposit.DestinationNetwork)
-	if err != nil {
+	pbDeposit := depositToPb(deposit, claimTxHash, readyForClaim)
+	if pbDeposit.Eta, err = s.depositEta(ctx, deposit, claimTxHash, readyForClaim); err != nil {
 		return nil, err
 	}
-
 	return &pb.GetBridgeResponse{
-		Deposit: &pb.Deposit{
-			LeafType:      uint32(deposit.LeafType),
-			OrigNet:       uint32(deposit.OriginalNetwork),
-			OrigAddr:      deposit.OriginalAddress.Hex(),
-			Amount:        deposit.Amount.String(),
-			DestNet:       uint32(deposit.DestinationNetwork),
-			DestAddr:      deposit.DestinationAddress.Hex(),
-			BlockNum:      deposit.BlockNumber,
-			DepositCnt:    uint64(deposit.DepositCount),
-			NetworkId:     uint32(deposit.NetworkID),
-			TxHash:        deposit.TxHash.String(),
-			ClaimTxHash:   claimTxHash,
-			Metadata:      "0x" + hex.EncodeToString(deposit.Metadata),
-			ReadyForClaim: readyForClaim,
-		},
+		Deposit: pbDeposit,
 	}, nil
 }
 
-// GetTokenWrapped returns the token wrapped created for a specific network
-func (s *bridgeService) GetTokenWrapped(ctx context.Context, req *pb.GetTokenWrappedRequest) (*pb.GetTokenWrappedResponse, error) {
-	tokenWrapped, err := s.bridgeCtrl.GetTokenWrapped(uint(req.OrigNet), common.HexToAddress(req.OrigTokenAddr))
+// depositEta returns the estimated time the deposit will be claimable, nil if it isn't pending or its network hasn't
+// enough recent deposits.
+func (s *bridgeService) depositEta(ctx context.Context, deposit *etherman.Deposit, claimTxHash string, readyForClaim bool) (*pb.DepositEta, error) {
+	if readyForClaim || claimTxHash != "" {
+		return nil, nil
+	}
+	latency, err := s.eta.estimate(ctx, deposit.NetworkID)
+	if err != nil || latency == nil {
+		return nil, err
+	}
+	return depositEtaToPb(deposit, latency, time.Now()), nil
+}
+
+// GetLatencySummary returns the percentiles of the time the recent deposits of every network took to be claimable.
+func (s *bridgeService) GetLatencySummary(ctx context.Context, req *pb.GetLatencySummaryRequest) (*pb.GetLatencySummaryResponse, error) {
+	latencies, err := s.eta.getLatencies(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetTokenWrappedResponse{
-		Tokenwrapped: &pb.TokenWrapped{
-			OrigNet:           uint32(tokenWrapped.OriginalNetwork),
-			OriginalTokenAddr: tokenWrapped.OriginalTokenAddress.Hex(),
-			WrappedTokenAddr:  tokenWrapped.WrappedTokenAddress.Hex(),
-			NetworkId:         uint32(tokenWrapped.NetworkID),
-			Name:              tokenWrapped.Name,
-			Symbol:            tokenWrapped.Symbol,
-			Decimals:          uint32(tokenWrapped.Decimals),
-		},
+	pbLatencies := make([]*pb.NetworkLatency, 0, len(latencies))
+	for _, latency := range latencies {
+		pbLatencies = append(pbLatencies, &pb.NetworkLatency{
+			NetworkId: uint32(latency.NetworkID),
+			Samples:   latency.Samples,
+			P10:       latency.P10.Seconds(),
+			P50:       latency.P50.Seconds(),
+			P90:       latency.P90.Seconds(),
+		})
+	}
+	return &pb.GetLatencySummaryResponse{
+		Networks: pbLatencies,
+		Window:   uint64(latencyWindow.Seconds()),
 	}, nil
 }
 
-func (s *bridgeService) getDepositStatus(ctx context.Context, depositCount uint, networkID uint, destNetworkID uint) (string, bool, error) {
+// GetDepositTimeline returns the history of the states of the deposit, and the histories of the deposits with the
+// same count removed by a reorg.
+func (s *bridgeService) GetDepositTimeline(ctx context.Context, req *pb.GetDepositTimelineRequest) (*pb.GetDepositTimelineResponse, error) {
 	var (
-		claimTxHash string
-		exitRoot    *etherman.GlobalExitRoot
+		deposit     *etherman.Deposit
+		transitions []*etherman.DepositTransition
 	)
-	// Get the claim tx hash
-	claim, err := s.storage.GetClaim(ctx, depositCount, destNetworkID, nil)
+	err := s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		deposit, err = s.storage.GetDeposit(ctx, uint(req.DepositCnt), uint(req.NetId), dbTx)
+		if err != nil && err != gerror.ErrStorageNotFound {
+			return err
+		}
+		transitions, err = s.storage.GetDepositTransitions(ctx, uint(req.NetId), uint(req.DepositCnt), dbTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The transitions are grouped by the block of their deposit, the current deposit is the stored one
+	res := &pb.GetDepositTimelineResponse{State: pb.DepositState_DEPOSIT_STATE_REORGED}
+	if deposit != nil {
+		res.State = pb.DepositState(deposit.State)
+	}
+	var history *pb.DepositHistory
+	for i, transition := range transitions {
+		pbTransition := &pb.DepositTransition{
+			State:     pb.DepositState(transition.State),
+			NetworkId: uint32(transition.BlockNetworkID),
+			BlockNum:  transition.BlockNumber,
+		}
+		if !transition.Time.IsZero() {
+			pbTransition.Time = transition.Time.Unix()
+		}
+		if deposit != nil && transition.DepositBlockID == deposit.BlockID {
+			res.Transitions = append(res.Transitions, pbTransition)
+			continue
+		}
+		if i == 0 || transition.DepositBlockID != transitions[i-1].DepositBlockID {
+			history = &pb.DepositHistory{}
+			res.Reorged = append(res.Reorged, history)
+		}
+		history.Transitions = append(history.Transitions, pbTransition)
+	}
+	return res, nil
+}
+
+// GetBridgeByTxHash returns all the deposits of the transaction with their status.
+func (s *bridgeService) GetBridgeByTxHash(ctx context.Context, req *pb.GetBridgeByTxHashRequest) (*pb.GetBridgeByTxHashResponse, error) {
+	txHash, err := parseTxHash(req.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	var pbDeposits []*pb.Deposit
+	err = s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		deposits, err := s.storage.GetDepositsByTxHash(ctx, txHash, dbTx)
+		if err != nil {
+			return err
+		}
+		for _, deposit := range deposits {
+			claimTxHash, readyForClaim, err := s.getDepositStatus(ctx, deposit, dbTx)
+			if err != nil {
+				return err
+			}
+			pbDeposits = append(pbDeposits, depositToPb(deposit, claimTxHash, readyForClaim))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetBridgeByTxHashResponse{
+		Deposits: pbDeposits,
+	}, nil
+}
+
+// GetClaimByTxHash returns all the claims of the transaction.
+func (s *bridgeService) GetClaimByTxHash(ctx context.Context, req *pb.GetClaimByTxHashRequest) (*pb.GetClaimByTxHashResponse, error) {
+	txHash, err := parseTxHash(req.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := s.storage.GetClaimsByTxHash(ctx, txHash, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbClaims []*pb.Claim
+	for _, claim := range claims {
+		pbClaims = append(pbClaims, claimToPb(claim))
+	}
+	return &pb.GetClaimByTxHashResponse{
+		Claims: pbClaims,
+	}, nil
+}
+
+// parseTxHash decodes the hex transaction hash of a request.
+func parseTxHash(s string) (common.Hash, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, gerror.ErrInvalidTxHash
+	}
+	return common.BytesToHash(b), nil
+}
+
+// SubscribeDepositStatus sends the status changes of the deposits of the destination address, or of the deposit, until
+// the client cancels the request.
+func (s *bridgeService) SubscribeDepositStatus(req *pb.SubscribeDepositStatusRequest, stream pb.BridgeService_SubscribeDepositStatusServer) error {
+	if s.notifier == nil {
+		return status.Error(codes.Unimplemented, "deposit subscriptions are disabled")
+	}
+	var filter DepositSubscriptionFilter
+	if req.DestAddr != "" {
+		if !common.IsHexAddress(req.DestAddr) {
+			return gerror.ErrInvalidFilter
+		}
+		destAddr := common.HexToAddress(req.DestAddr)
+		filter.DestinationAddress = &destAddr
+	}
+	if req.NetId != nil && req.DepositCnt != nil {
+		filter.Deposit = &DepositKey{NetworkID: uint(*req.NetId), DepositCount: uint(*req.DepositCnt)}
+	}
+	if filter.DestinationAddress == nil && filter.Deposit == nil {
+		return gerror.ErrInvalidFilter
+	}
+
+	sub := s.notifier.Subscribe(filter)
+	defer sub.Unsubscribe()
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return sub.Err()
+			}
+			var (
+				claimTxHash   string
+				readyForClaim bool
+				eventType     pb.DepositEventType
+			)
+			switch event.Type {
+			case DepositIndexed:
+				eventType = pb.DepositEventType_DEPOSIT_EVENT_TYPE_INDEXED
+			case DepositReadyForClaim:
+				eventType, readyForClaim = pb.DepositEventType_DEPOSIT_EVENT_TYPE_READY_FOR_CLAIM, true
+			case DepositClaimed:
+				eventType, claimTxHash = pb.DepositEventType_DEPOSIT_EVENT_TYPE_CLAIMED, event.ClaimTxHash.String()
+			case DepositRemoved:
+				eventType = pb.DepositEventType_DEPOSIT_EVENT_TYPE_REMOVED
+			}
+			err := stream.Send(&pb.DepositStatusEvent{
+				Type:    eventType,
+				Deposit: depositToPb(event.Deposit, claimTxHash, readyForClaim),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func depositToPb(deposit *etherman.Deposit, claimTxHash string, readyForClaim bool) *pb.Deposit {
+	return &pb.Deposit{
+		LeafType:      uint32(deposit.LeafType),
+		OrigNet:       uint32(deposit.OriginalNetwork),
+		OrigAddr:      deposit.OriginalAddress.Hex(),
+		Amount:        deposit.Amount.String(),
+		DestNet:       uint32(deposit.DestinationNetwork),
+		DestAddr:      deposit.DestinationAddress.Hex(),
+		BlockNum:      deposit.BlockNumber,
+		DepositCnt:    uint64(deposit.DepositCount),
+		NetworkId:     uint32(deposit.NetworkID),
+		TxHash:        deposit.TxHash.String(),
+		ClaimTxHash:   claimTxHash,
+		Metadata:      "0x" + hex.EncodeToString(deposit.Metadata),
+		ReadyForClaim: readyForClaim,
+		SenderAddr:    deposit.Sender.Hex(),
+	}
+}
+
+func claimToPb(claim *etherman.Claim) *pb.Claim {
+	return &pb.Claim{
+		Index:     uint64(claim.Index),
+		OrigNet:   uint32(claim.OriginalNetwork),
+		OrigAddr:  claim.OriginalAddress.Hex(),
+		Amount:    claim.Amount.String(),
+		NetworkId: uint32(claim.NetworkID),
+		DestAddr:  claim.DestinationAddress.Hex(),
+		BlockNum:  claim.BlockNumber,
+		TxHash:    claim.TxHash.String(),
+		SourceNet: uint32(claim.SourceNetwork),
+	}
+}
+
+// VerifyProof recomputes the leaf of the stored deposit and checks the merkle proof against the supplied exit root.
+// If the exit roots of a global exit root are supplied, the exit root is checked against them too, with the rollup
+// proof for the rollup deposits, so the whole proof of a claim is verified. All the reads see the same snapshot.
+func (s *bridgeService) VerifyProof(ctx context.Context, req *pb.VerifyProofRequest) (*pb.VerifyProofResponse, error) {
+	verifyExitRoots := req.MainnetExitRoot != "" || req.RollupExitRoot != ""
+	if verifyExitRoots && (req.MainnetExitRoot == "" || req.RollupExitRoot == "") {
+		return nil, gerror.ErrMissingExitRoot
+	}
+	mt, tID, err := s.bridgeCtrl.exitTree(uint(req.NetId))
+	if err != nil {
+		return nil, err
+	}
+	var res *pb.VerifyProofResponse
+	err = s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		res, err = s.verifyProof(ctx, req, mt, tID, verifyExitRoots, dbTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// verifyProof checks the merkle proof of the request reading inside dbTx. Without a supplied root, the root computed
+// from the proof is checked against the exit roots.
+func (s *bridgeService) verifyProof(ctx context.Context, req *pb.VerifyProofRequest, mt *MerkleTree, tID uint8, verifyExitRoots bool, dbTx pgx.Tx) (*pb.VerifyProofResponse, error) {
+	deposit, err := s.storage.GetDeposit(ctx, uint(req.DepositCnt), uint(req.NetId), dbTx)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf := HashDeposit(deposit)
+	res := &pb.VerifyProofResponse{
+		Leaf: "0x" + hex.EncodeToString(leaf[:]),
+	}
+	if len(req.MerkleProof) != int(mt.height) {
+		res.Reason = pb.ProofMismatchReason_PROOF_MISMATCH_REASON_INVALID_LENGTH
+		return res, nil
+	}
+
+	computedRoot := CalculateRoot(leaf, uint(req.DepositCnt), hexToSiblings(req.MerkleProof))
+	res.ComputedRoot = "0x" + hex.EncodeToString(computedRoot[:])
+	root := computedRoot
+	if req.Root != "" || !verifyExitRoots {
+		root = common.HexToHash(req.Root)
+		if computedRoot != root {
+			res.Reason = pb.ProofMismatchReason_PROOF_MISMATCH_REASON_ROOT_MISMATCH
+			return res, nil
+		}
+	}
+
+	_, err = mt.store.GetDepositCountByRoot(ctx, root[:], tID, dbTx)
 	if err != nil {
 		if err != gerror.ErrStorageNotFound {
-			return "", false, err
+			return nil, err
 		}
-	} else {
-		claimTxHash = claim.TxHash.String()
+		res.Reason = pb.ProofMismatchReason_PROOF_MISMATCH_REASON_UNKNOWN_ROOT
+		return res, nil
 	}
-	// Get the claim readiness
-	if networkID == MainNetworkID {
-		exitRoot, err = s.bridgeCtrl.storage.GetLatestTrustedExitRoot(ctx, nil)
-	} else {
-		exitRoot, err = s.bridgeCtrl.storage.GetLatestL1SyncedExitRoot(ctx, nil)
+	if !verifyExitRoots {
+		res.Valid = true
+		return res, nil
 	}
 
+	// The pair of exit roots must be of a global exit root synced from the chain
+	mainnetExitRoot, rollupExitRoot := common.HexToHash(req.MainnetExitRoot), common.HexToHash(req.RollupExitRoot)
+	_, err = s.bridgeCtrl.storage.GetExitRootByGlobalExitRoot(ctx, hash(mainnetExitRoot, rollupExitRoot), dbTx)
 	if err != nil {
 		if err != gerror.ErrStorageNotFound {
-			return "", false, err
+			return nil, err
+		}
+		res.Reason = pb.ProofMismatchReason_PROOF_MISMATCH_REASON_UNKNOWN_EXIT_ROOT
+		return res, nil
+	}
+	exitRoot, expectedExitRoot := root, mainnetExitRoot
+	validIndex := true
+	if uint(req.NetId) != MainNetworkID {
+		expectedExitRoot = rollupExitRoot
+		// The rollup exit root synced without leaves is the local exit root of the verified rollup
+		if len(req.RollupMerkleProof) > 0 || tID != verifiedRollupTreeIndex {
+			if len(req.RollupMerkleProof) != int(mt.height) {
+				res.Reason = pb.ProofMismatchReason_PROOF_MISMATCH_REASON_INVALID_LENGTH
+				return res, nil
+			}
+			exitRoot = CalculateRoot(root, uint(req.RollupIndex), hexToSiblings(req.RollupMerkleProof))
+			validIndex = uint(req.RollupIndex) == rollupIndex(tID)
 		}
-		return claimTxHash, false, nil
 	}
+	res.ComputedExitRoot = "0x" + hex.EncodeToString(exitRoot[:])
+	if !validIndex || exitRoot != expectedExitRoot {
+		res.Reason = pb.ProofMismatchReason_PROOF_MISMATCH_REASON_EXIT_ROOT_MISMATCH
+		return res, nil
+	}
+	res.Valid = true
+	return res, nil
+}
 
-	tID, found := s.bridgeCtrl.networkIDs[networkID]
-	if !found {
-		return "", false, gerror.ErrNetworkNotRegister
+// hexToSiblings decodes the siblings of a merkle proof.
+func hexToSiblings(proof []string) [][KeyLen]byte {
+	siblings := make([][KeyLen]byte, 0, len(proof))
+	for _, sibling := range proof {
+		siblings = append(siblings, common.HexToHash(sibling))
 	}
-	depositCnt, err := s.bridgeCtrl.storage.GetDepositCountByRoot(ctx, exitRoot.ExitRoots[tID][:], uint8(tID), nil)
+	return siblings
+}
+
+// GetStats returns the volumes of the deposits and the claims, the outstanding amounts and the locked value of every
+// token in every network, computed from the rollups updated by the synchronizer.
+func (s *bridgeService) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.GetStatsResponse, error) {
+	windows := defaultStatsWindows
+	if len(req.Windows) > 0 {
+		if len(req.Windows) > maxStatsWindows {
+			return nil, gerror.ErrInvalidFilter
+		}
+		windows = make([]time.Duration, 0, len(req.Windows))
+		for _, window := range req.Windows {
+			if window == 0 || window > math.MaxInt64/uint64(time.Second) {
+				return nil, gerror.ErrInvalidFilter
+			}
+			windows = append(windows, time.Duration(window)*time.Second)
+		}
+	}
+	now := time.Now()
+	since := make([]time.Time, 0, len(windows))
+	for _, window := range windows {
+		since = append(since, now.Add(-window))
+	}
+	var stats []*etherman.TokenStats
+	err := s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		stats, err = s.storage.GetTokenStats(ctx, since, dbTx)
+		return err
+	})
 	if err != nil {
-		if err != gerror.ErrStorageNotFound {
-			return "", false, err
+		return nil, err
+	}
+
+	pbStats := make([]*pb.TokenStats, 0, len(stats))
+	for _, tokenStats := range stats {
+		if req.NetId != nil && tokenStats.NetworkID != uint(*req.NetId) {
+			continue
+		}
+		pbWindows := make([]*pb.TokenVolume, 0, len(windows))
+		for i, window := range windows {
+			pbWindows = append(pbWindows, tokenVolumeToPb(tokenStats.Windows[i], window))
+		}
+		pbStats = append(pbStats, &pb.TokenStats{
+			NetworkId:         uint32(tokenStats.NetworkID),
+			OrigNet:           uint32(tokenStats.OriginalNetwork),
+			OrigTokenAddr:     tokenStats.OriginalAddress.Hex(),
+			Total:             tokenVolumeToPb(tokenStats.Total, 0),
+			Windows:           pbWindows,
+			OutstandingAmount: tokenStats.OutstandingAmount.String(),
+			LockedAmount:      tokenStats.LockedAmount.String(),
+		})
+	}
+	return &pb.GetStatsResponse{
+		Stats: pbStats,
+	}, nil
+}
+
+func tokenVolumeToPb(volume etherman.TokenVolume, window time.Duration) *pb.TokenVolume {
+	return &pb.TokenVolume{
+		Window:        uint64(window.Seconds()),
+		DepositCnt:    volume.DepositCount,
+		DepositAmount: volume.DepositAmount.String(),
+		ClaimCnt:      volume.ClaimCount,
+		ClaimAmount:   volume.ClaimAmount.String(),
+	}
+}
+
+// GetTokenWrapped returns the token wrapped created for a specific network, or for any network when the clients don't
+// set it
+func (s *bridgeService) GetTokenWrapped(ctx context.Context, req *pb.GetTokenWrappedRequest) (*pb.GetTokenWrappedResponse, error) {
+	var networkID *uint
+	if req.DestNet != nil {
+		destNet := uint(*req.DestNet)
+		networkID = &destNet
+	}
+	tokenWrapped, err := s.bridgeCtrl.GetTokenWrapped(uint(req.OrigNet), common.HexToAddress(req.OrigTokenAddr), networkID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTokenWrappedResponse{
+		Tokenwrapped: tokenWrappedToPb(tokenWrapped),
+	}, nil
+}
+
+// GetTokenWrappedByAddress returns the original token and the metadata of the wrapped token address in the network.
+func (s *bridgeService) GetTokenWrappedByAddress(ctx context.Context, req *pb.GetTokenWrappedByAddressRequest) (*pb.GetTokenWrappedResponse, error) {
+	if !common.IsHexAddress(req.WrappedTokenAddr) {
+		return nil, gerror.ErrInvalidFilter
+	}
+	tokenWrapped, err := s.storage.GetTokenWrappedByAddress(ctx, common.HexToAddress(req.WrappedTokenAddr), uint(req.NetId), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTokenWrappedResponse{
+		Tokenwrapped: tokenWrappedToPb(tokenWrapped),
+	}, nil
+}
+
+// ListTokenWrapped returns the wrapped tokens matching the filters of the request both in L1 and L2, with their total
+// count.
+func (s *bridgeService) ListTokenWrapped(ctx context.Context, req *pb.ListTokenWrappedRequest) (*pb.ListTokenWrappedResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	filter := &etherman.TokenWrappedFilter{Symbol: req.Symbol}
+	if req.NetId != nil {
+		networkID := uint(*req.NetId)
+		filter.NetworkID = &networkID
+	}
+	var (
+		totalCount uint64
+		tokens     []*etherman.TokenWrapped
+	)
+	err := s.bridgeCtrl.readSnapshot(ctx, func(dbTx pgx.Tx) error {
+		var err error
+		totalCount, err = s.storage.GetTokenWrappedCount(ctx, filter, dbTx)
+		if err != nil {
+			return err
 		}
-		depositCnt = 0
+		tokens, err = s.storage.GetTokensWrapped(ctx, filter, uint(limit), uint(req.Offset), dbTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return claimTxHash, depositCnt > depositCount, nil
+	pbTokens := make([]*pb.TokenWrapped, 0, len(tokens))
+	for _, token := range tokens {
+		pbTokens = append(pbTokens, tokenWrappedToPb(token))
+	}
+	return &pb.ListTokenWrappedResponse{
+		TokensWrapped: pbTokens,
+		TotalCnt:      totalCount,
+	}, nil
+}
+
+func tokenWrappedToPb(tokenWrapped *etherman.TokenWrapped) *pb.TokenWrapped {
+	return &pb.TokenWrapped{
+		OrigNet:           uint32(tokenWrapped.OriginalNetwork),
+		OriginalTokenAddr: tokenWrapped.OriginalTokenAddress.Hex(),
+		WrappedTokenAddr:  tokenWrapped.WrappedTokenAddress.Hex(),
+		NetworkId:         uint32(tokenWrapped.NetworkID),
+		Name:              tokenWrapped.Name,
+		Symbol:            tokenWrapped.Symbol,
+		Decimals:          uint32(tokenWrapped.Decimals),
+	}
+}
+
+// getDepositStatus returns the hash of the claim tx and whether the deposit is ready to be claimed, reading inside dbTx.
+// The readiness is read from the state of the deposit, and the claim is only read for the claimed deposits.
+func (s *bridgeService) getDepositStatus(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) (string, bool, error) {
+	readyForClaim := deposit.State >= etherman.DepositStateClaimable
+	if deposit.State != etherman.DepositStateClaimed {
+		return "", readyForClaim, nil
+	}
+	claim, err := s.storage.GetClaim(ctx, deposit.DepositCount, deposit.DestinationNetwork, deposit.NetworkID, dbTx)
+	if err != nil {
+		if err == gerror.ErrStorageNotFound {
+			return "", readyForClaim, nil
+		}
+		return "", false, err
+	}
+	return claim.TxHash.String(), readyForClaim, nil
 }
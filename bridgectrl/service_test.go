@@ -0,0 +1,121 @@
+package bridgectrl
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+// serviceStorage reads the deposits of the memory storage for the bridge service, the rest of the methods aren't
+// used by the tests.
+type serviceStorage struct {
+	BridgeServiceStorage
+	store *memoryStorage
+}
+
+func (s *serviceStorage) GetDeposit(ctx context.Context, depositCnt uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
+	deposits, err := s.store.GetNetworkDeposits(ctx, networkID, depositCnt, 1, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(deposits) == 0 || deposits[0].DepositCount != depositCnt {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return deposits[0], nil
+}
+
+func TestVerifyProof(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryStorage()
+	bt, err := NewBridgeController(Config{Height: 32}, []uint{0, 1000, 1001}, store, kvstorage.NewMemoryStorage())
+	require.NoError(t, err)
+	s := NewBridgeService(&serviceStorage{store: store}, bt, nil)
+
+	for _, networkID := range []uint{0, 1001, 1000} {
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x1"),
+			Amount:             big.NewInt(int64(networkID) + 1),
+			DestinationAddress: common.HexToAddress("0x2"),
+			BlockID:            1,
+			NetworkID:          networkID,
+		}
+		require.NoError(t, store.AddDeposit(ctx, deposit, nil))
+		require.NoError(t, bt.MockAddDeposit(deposit))
+	}
+	// The exit roots of the proofs are found by their global exit root
+	exitRoot, err := store.GetLatestL1SyncedExitRoot(ctx, nil)
+	require.NoError(t, err)
+	exitRoot.GlobalExitRoot = hash(exitRoot.ExitRoots[0], exitRoot.ExitRoots[1])
+
+	verifyRequest := func(networkID uint) *pb.VerifyProofRequest {
+		proof, err := bt.GetClaimByExitRoot(networkID, 0, exitRoot)
+		require.NoError(t, err)
+		pbProof := proofToPb(proof, exitRoot)
+		return &pb.VerifyProofRequest{
+			NetId:             uint32(networkID),
+			MerkleProof:       pbProof.MerkleProof,
+			MainnetExitRoot:   pbProof.MainExitRoot,
+			RollupExitRoot:    pbProof.RollupExitRoot,
+			RollupMerkleProof: pbProof.RollupMerkleProof,
+			RollupIndex:       pbProof.RollupIndex,
+		}
+	}
+
+	for _, networkID := range []uint{0, 1000, 1001} {
+		res, err := s.VerifyProof(ctx, verifyRequest(networkID))
+		require.NoError(t, err)
+		require.True(t, res.Valid, "network %d", networkID)
+		require.NotEmpty(t, res.ComputedExitRoot)
+	}
+
+	// The local proof is valid, but the rollup proof isn't
+	req := verifyRequest(1001)
+	req.RollupIndex = 0
+	res, err := s.VerifyProof(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, pb.ProofMismatchReason_PROOF_MISMATCH_REASON_EXIT_ROOT_MISMATCH, res.Reason)
+
+	req = verifyRequest(1001)
+	req.RollupMerkleProof[0] = common.HexToHash("0x1").String()
+	res, err = s.VerifyProof(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, pb.ProofMismatchReason_PROOF_MISMATCH_REASON_EXIT_ROOT_MISMATCH, res.Reason)
+
+	req = verifyRequest(1001)
+	req.RollupMerkleProof = req.RollupMerkleProof[:31]
+	res, err = s.VerifyProof(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, pb.ProofMismatchReason_PROOF_MISMATCH_REASON_INVALID_LENGTH, res.Reason)
+
+	// The exit roots must be of a synced global exit root
+	req = verifyRequest(0)
+	req.RollupExitRoot = common.HexToHash("0x1").String()
+	res, err = s.VerifyProof(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, pb.ProofMismatchReason_PROOF_MISMATCH_REASON_UNKNOWN_EXIT_ROOT, res.Reason)
+
+	req = verifyRequest(0)
+	req.RollupExitRoot = ""
+	_, err = s.VerifyProof(ctx, req)
+	require.ErrorIs(t, err, gerror.ErrMissingExitRoot)
+
+	// Without the exit roots only the local proof is verified
+	req = verifyRequest(1001)
+	req.Root = req.MainnetExitRoot
+	req.MainnetExitRoot, req.RollupExitRoot = "", ""
+	res, err = s.VerifyProof(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, pb.ProofMismatchReason_PROOF_MISMATCH_REASON_ROOT_MISMATCH, res.Reason)
+	req.Root = res.ComputedRoot
+	res, err = s.VerifyProof(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.Valid)
+}
@@ -0,0 +1,154 @@
+package bridgectrl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+// testTx only identifies a db transaction, the in-memory stores don't use it
+type testTx struct {
+	pgx.Tx
+}
+
+// memoryStorage keeps in memory the synced data read by the bridge controller and the tree checker. The db
+// transactions are ignored, the changes are visible as soon as they are written.
+type memoryStorage struct {
+	mu           sync.Mutex
+	exitRoots    []*etherman.GlobalExitRoot
+	rollupLeaves map[common.Hash][]*etherman.RollupExitLeaf
+	// rollupRoots keeps the rollup exit roots in the order they are stored
+	rollupRoots []common.Hash
+	deposits    map[uint][]*etherman.Deposit
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{
+		rollupLeaves: make(map[common.Hash][]*etherman.RollupExitLeaf),
+		deposits:     make(map[uint][]*etherman.Deposit),
+	}
+}
+
+func (s *memoryStorage) BeginDBTransaction(ctx context.Context) (pgx.Tx, error) {
+	return &testTx{}, nil
+}
+
+func (s *memoryStorage) BeginSnapshotDBTransaction(ctx context.Context) (pgx.Tx, error) {
+	return &testTx{}, nil
+}
+
+func (s *memoryStorage) Commit(ctx context.Context, dbTx pgx.Tx) error {
+	return nil
+}
+
+func (s *memoryStorage) Rollback(ctx context.Context, dbTx pgx.Tx) error {
+	return nil
+}
+
+// AddDeposit adds the deposit, the deposits of every network must be added in the order of their deposit count.
+func (s *memoryStorage) AddDeposit(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deposits[deposit.NetworkID] = append(s.deposits[deposit.NetworkID], deposit)
+	return nil
+}
+
+func (s *memoryStorage) GetNetworkDeposits(ctx context.Context, networkID uint, fromDepositCnt uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var deposits []*etherman.Deposit
+	for _, deposit := range s.deposits[networkID] {
+		if deposit.DepositCount >= fromDepositCnt && uint(len(deposits)) < limit {
+			deposits = append(deposits, deposit)
+		}
+	}
+	return deposits, nil
+}
+
+func (s *memoryStorage) GetNetworkDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deposits := s.deposits[networkID]
+	if len(deposits) == 0 {
+		return 0, nil
+	}
+	return deposits[len(deposits)-1].DepositCount + 1, nil
+}
+
+func (s *memoryStorage) AddGlobalExitRoot(ctx context.Context, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.exitRoots = append(s.exitRoots, globalExitRoot)
+	return nil
+}
+
+func (s *memoryStorage) AddTrustedGlobalExitRoot(ctx context.Context, trustedExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) error {
+	ger := *trustedExitRoot
+	ger.BlockID = 0
+	return s.AddGlobalExitRoot(ctx, &ger, dbTx)
+}
+
+// latestExitRoot returns the latest exit root which matches, or ErrStorageNotFound.
+func (s *memoryStorage) latestExitRoot(match func(ger *etherman.GlobalExitRoot) bool) (*etherman.GlobalExitRoot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.exitRoots) - 1; i >= 0; i-- {
+		if match(s.exitRoots[i]) {
+			return s.exitRoots[i], nil
+		}
+	}
+	return nil, gerror.ErrStorageNotFound
+}
+
+func (s *memoryStorage) GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	return s.latestExitRoot(func(ger *etherman.GlobalExitRoot) bool { return ger.BlockID > 0 })
+}
+
+func (s *memoryStorage) GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	return s.latestExitRoot(func(ger *etherman.GlobalExitRoot) bool { return ger.BlockID == 0 })
+}
+
+func (s *memoryStorage) GetExitRootByGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	return s.latestExitRoot(func(ger *etherman.GlobalExitRoot) bool { return ger.GlobalExitRoot == globalExitRoot })
+}
+
+func (s *memoryStorage) AddRollupExitLeaves(ctx context.Context, rollupExitRoot common.Hash, leaves []*etherman.RollupExitLeaf, blockID uint64, dbTx pgx.Tx) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, found := s.rollupLeaves[rollupExitRoot]; !found {
+		s.rollupLeaves[rollupExitRoot] = leaves
+		s.rollupRoots = append(s.rollupRoots, rollupExitRoot)
+	}
+	return nil
+}
+
+func (s *memoryStorage) GetRollupExitLeaves(ctx context.Context, rollupExitRoot common.Hash, dbTx pgx.Tx) ([]*etherman.RollupExitLeaf, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	leaves, found := s.rollupLeaves[rollupExitRoot]
+	if !found {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return leaves, nil
+}
+
+func (s *memoryStorage) GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]*etherman.RollupExitLeaf, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.rollupRoots) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return s.rollupLeaves[s.rollupRoots[len(s.rollupRoots)-1]], nil
+}
+
+func (s *memoryStorage) GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, networkID *uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	return nil, gerror.ErrStorageNotFound
+}
+
+func (s *memoryStorage) GetClaimedDepositNetwork(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (uint, error) {
+	return 0, gerror.ErrStorageNotFound
+}
@@ -0,0 +1,20 @@
+package bridgectrl
+
+// CalculateRoot computes the root of the tree from the leaf at index and its siblings, ordered from the leaf level to the top.
+func CalculateRoot(leaf [KeyLen]byte, index uint, siblings [][KeyLen]byte) [KeyLen]byte {
+	cur := leaf
+	for h, sibling := range siblings {
+		if index&(1<<h) > 0 {
+			cur = hash(sibling, cur)
+		} else {
+			cur = hash(cur, sibling)
+		}
+	}
+	return cur
+}
+
+// VerifyMerkleProof checks the merkle proof of the leaf at index against the root. It follows the same logic as
+// the verifyMerkleProof function of the bridge smart contract, so the leaf must be built with HashDeposit.
+func VerifyMerkleProof(leaf [KeyLen]byte, index uint, siblings [][KeyLen]byte, root [KeyLen]byte) bool {
+	return CalculateRoot(leaf, index, siblings) == root
+}
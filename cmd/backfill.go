@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/urfave/cli/v2"
+)
+
+// backfillBatchSize is the number of deposits updated in each db transaction of the backfill
+const backfillBatchSize = 1000
+
+type backfillStorage interface {
+	GetNetworks(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Network, error)
+	GetDepositsWithoutSender(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
+	UpdateDepositSender(ctx context.Context, networkID uint, depositCnt uint, sender common.Address, dbTx pgx.Tx) error
+	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Commit(ctx context.Context, dbTx pgx.Tx) error
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
+}
+
+// backfillSenders stores the sender of the deposits synced before it was stored, reading it from the transactions
+// of the configured networks and of the networks registered at runtime.
+func backfillSenders(ctx *cli.Context) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	setupLog(c.Log)
+	err = db.RunMigrations(c.Database)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	l1Etherman, l2Ethermans, err := newEthermans(*c)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	networkIDs, err := getNetworkIDs(l1Etherman, l2Ethermans)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	s, err := db.NewStorage(c.Database)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	storage := s.(backfillStorage)
+
+	clients := append([]*etherman.Client{l1Etherman}, l2Ethermans...)
+	networks, err := storage.GetNetworks(ctx.Context, nil)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	for _, network := range networks {
+		if !network.Active {
+			continue
+		}
+		client, err := etherman.NewL2Client(network.RPCURL, network.BridgeAddr)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		networkIDs = append(networkIDs, network.NetworkID)
+		clients = append(clients, client)
+	}
+
+	for i, networkID := range networkIDs {
+		count, err := backfillNetworkSenders(ctx.Context, storage, clients[i], networkID)
+		if err != nil {
+			log.Errorf("networkID: %d, error backfilling the deposit senders. Error: %s", networkID, err.Error())
+			return err
+		}
+		log.Infof("networkID: %d, %d deposit senders backfilled", networkID, count)
+	}
+	return nil
+}
+
+// backfillNetworkSenders stores the sender of the deposits of the network without it, and returns the number of
+// updated deposits.
+func backfillNetworkSenders(ctx context.Context, storage backfillStorage, client *etherman.Client, networkID uint) (uint64, error) {
+	var count uint64
+	for {
+		deposits, err := storage.GetDepositsWithoutSender(ctx, networkID, backfillBatchSize, nil)
+		if err != nil {
+			return count, err
+		}
+		if len(deposits) == 0 {
+			return count, nil
+		}
+		// The deposits of the same transaction have the same sender
+		senders := make(map[common.Hash]common.Address)
+		for _, deposit := range deposits {
+			if _, found := senders[deposit.TxHash]; found {
+				continue
+			}
+			sender, err := client.GetTxSender(ctx, deposit.TxHash)
+			if err != nil {
+				return count, err
+			}
+			senders[deposit.TxHash] = sender
+		}
+
+		dbTx, err := storage.BeginDBTransaction(ctx)
+		if err != nil {
+			return count, err
+		}
+		for _, deposit := range deposits {
+			err = storage.UpdateDepositSender(ctx, networkID, deposit.DepositCount, senders[deposit.TxHash], dbTx)
+			if err != nil {
+				rollbackErr := storage.Rollback(ctx, dbTx)
+				if rollbackErr != nil {
+					log.Errorf("networkID: %d, error rolling back the deposit senders. RollbackErr: %s, err: %s", networkID, rollbackErr.Error(), err.Error())
+				}
+				return count, err
+			}
+		}
+		if err = storage.Commit(ctx, dbTx); err != nil {
+			return count, err
+		}
+		count += uint64(len(deposits))
+		log.Debugf("networkID: %d, deposit senders backfilled up to deposit count %d", networkID, deposits[len(deposits)-1].DepositCount)
+	}
+}
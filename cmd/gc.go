@@ -0,0 +1,46 @@
+package main
+
+import (
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/urfave/cli/v2"
+)
+
+func runGC(ctx *cli.Context) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	setupLog(c.Log)
+	err = db.RunMigrations(c.Database)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	storage, err := db.NewStorage(c.Database)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	// The embedded stores can't be opened while the service is running, it collects them periodically instead
+	mtStore, err := newMerkleTreeStore(c.BridgeController, storage)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer closeMerkleTreeStore(mtStore)
+
+	start := time.Now()
+	count, err := bridgectrl.NewGarbageCollector(c.BridgeController, mtStore).Collect(ctx.Context)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	log.Infof("%d unreachable merkle tree nodes removed in %s", count, time.Since(start))
+	return nil
+}
@@ -42,6 +42,13 @@ func main() {
 		},
 	}
 
+	snapshotFileFlag := &cli.StringFlag{
+		Name:     flagFile,
+		Aliases:  []string{"f"},
+		Usage:    "Snapshot `FILE`",
+		Required: true,
+	}
+
 	app.Commands = []*cli.Command{
 		{
 			Name:    "version",
@@ -56,6 +63,57 @@ func main() {
 			Action:  start,
 			Flags:   flags,
 		},
+		{
+			Name:    "gc",
+			Aliases: []string{},
+			Usage:   "Remove the merkle tree nodes unreachable from any root",
+			Action:  runGC,
+			Flags:   flags,
+		},
+		{
+			Name:    "verify-tree",
+			Aliases: []string{},
+			Usage:   "Verify the exit trees against the stored deposits",
+			Action:  verifyTree,
+			Flags: append(flags, &cli.BoolFlag{
+				Name:     flagFix,
+				Usage:    "Rebuild the exit trees which diverge from the stored deposits",
+				Required: false,
+			}),
+		},
+		{
+			Name:    "rebuild-tree",
+			Aliases: []string{},
+			Usage:   "Rebuild the exit trees from the stored deposits",
+			Action:  rebuildTree,
+			Flags:   flags,
+		},
+		{
+			Name:    "backfill-senders",
+			Aliases: []string{},
+			Usage:   "Store the sender of the deposits synced before it was indexed",
+			Action:  backfillSenders,
+			Flags:   flags,
+		},
+		{
+			Name:    "snapshot",
+			Aliases: []string{},
+			Usage:   "Export or import a snapshot of the bridge state",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "export",
+					Usage:  "Write a snapshot of the synced state to a file",
+					Action: exportSnapshot,
+					Flags:  append(flags, snapshotFileFlag),
+				},
+				{
+					Name:   "import",
+					Usage:  "Restore a snapshot file into an empty database",
+					Action: importSnapshot,
+					Flags:  append(flags, snapshotFileFlag),
+				},
+			},
+		},
 		{
 			Name:    "mockserver",
 			Aliases: []string{},
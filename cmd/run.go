@@ -2,23 +2,32 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/kvstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/0xPolygonHermez/zkevm-node/sequencer/broadcast/pb"
+	"github.com/jackc/pgx/v4"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+const (
+	storePostgres = "postgres"
+	storeBolt     = "bbolt"
+)
+
 func start(ctx *cli.Context) error {
 	configFilePath := ctx.String(flagCfg)
 	network := ctx.String(flagNetwork)
@@ -40,48 +49,29 @@ func start(ctx *cli.Context) error {
 		return err
 	}
 
-	networkID, err := etherman.GetNetworkID(context.Background())
-	log.Infof("main network id: %d", networkID)
+	networkIDs, err := getNetworkIDs(etherman, l2Ethermans)
 	if err != nil {
 		log.Error(err)
 		return err
 	}
 
-	var networkIDs = []uint{networkID}
-
-	for _, client := range l2Ethermans {
-		networkID, err := client.GetNetworkID(context.Background())
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-		log.Infof("l2 network id: %d", networkID)
-		networkIDs = append(networkIDs, networkID)
-	}
-
 	storage, err := db.NewStorage(c.Database)
 	if err != nil {
 		log.Error(err)
 		return err
 	}
 
-	var bridgeController *bridgectrl.BridgeController
-
-	if c.BridgeController.Store == "postgres" {
-		bridgeController, err = bridgectrl.NewBridgeController(c.BridgeController, networkIDs, storage, storage)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
+	mtStore, err := newMerkleTreeStore(c.BridgeController, storage)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer closeMerkleTreeStore(mtStore)
 
-		err = server.RunServer(storage, bridgeController, c.BridgeServer)
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-	} else {
-		log.Error(gerror.ErrStorageNotRegister)
-		return gerror.ErrStorageNotRegister
+	bridgeController, err := bridgectrl.NewBridgeController(c.BridgeController, networkIDs, storage, mtStore)
+	if err != nil {
+		log.Error(err)
+		return err
 	}
 
 	opts := []grpc.DialOption{
@@ -92,9 +82,40 @@ func start(ctx *cli.Context) error {
 		log.Fatal("error creating grpc connection. Error: ", err)
 	}
 	broadcastClient := pb.NewBroadcastServiceClient(conn)
-	go runSynchronizer(c.NetworkConfig.GenBlockNumber, bridgeController, etherman, c.Synchronizer, storage, broadcastClient)
+	notifier := bridgectrl.NewDepositNotifier(bridgeController, storage)
+	var webhooks server.Webhooks
+	if c.Webhook.Workers > 0 {
+		dispatcher := webhook.NewDispatcher(c.Webhook, storage)
+		go dispatcher.Start(ctx.Context)
+		webhooks = dispatcher
+	}
+	go notifier.Start(ctx.Context)
+	networkManager := synchronizer.NewNetworkManager(storage, bridgeController, broadcastClient, notifier, len(networkIDs), c.Synchronizer)
+	err = networkManager.Run(ctx.Context, etherman, c.NetworkConfig.GenBlockNumber)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
 	for _, client := range l2Ethermans {
-		go runSynchronizer(0, bridgeController, client, c.Synchronizer, storage, broadcastClient)
+		err = networkManager.Run(ctx.Context, client, 0)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+	}
+	err = networkManager.LoadNetworks(ctx.Context)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err = server.RunServer(storage, bridgeController, notifier, networkManager, webhooks, c.BridgeServer)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if c.BridgeController.GCInterval.Duration > 0 {
+		go bridgectrl.NewGarbageCollector(c.BridgeController, mtStore).Start(ctx.Context)
 	}
 
 	// Wait for an in interrupt.
@@ -128,12 +149,69 @@ func newEthermans(c config.Config) (*etherman.Client, []*etherman.Client, error)
 	return l1Etherman, l2Ethermans, nil
 }
 
-func runSynchronizer(genBlockNumber uint64, brdigeCtrl *bridgectrl.BridgeController, etherman *etherman.Client, cfg synchronizer.Config, storage db.Storage, broadcastClient pb.BroadcastServiceClient) {
-	sy, err := synchronizer.NewSynchronizer(storage, brdigeCtrl, etherman, broadcastClient, genBlockNumber, cfg)
+// getNetworkIDs returns the network ids of the main network and the l2 networks. The position of each network
+// is the index of its exit tree.
+func getNetworkIDs(l1Etherman *etherman.Client, l2Ethermans []*etherman.Client) ([]uint, error) {
+	networkID, err := l1Etherman.GetNetworkID(context.Background())
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	if err := sy.Sync(); err != nil {
-		log.Fatal(err)
+	log.Infof("main network id: %d", networkID)
+
+	var networkIDs = []uint{networkID}
+	for _, client := range l2Ethermans {
+		networkID, err := client.GetNetworkID(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("l2 network id: %d", networkID)
+		networkIDs = append(networkIDs, networkID)
+	}
+	return networkIDs, nil
+}
+
+// newMerkleTreeStore creates the storage of the bridge trees selected in the config. The postgres store is the
+// bridge storage itself.
+func newMerkleTreeStore(c bridgectrl.Config, storage db.Storage) (interface{}, error) {
+	switch c.Store {
+	case storePostgres:
+		return storage, nil
+	case storeBolt:
+		store, err := kvstorage.NewBoltStorage(c.StorePath)
+		if err != nil {
+			return nil, fmt.Errorf("error opening the merkle tree store %s: %w", c.StorePath, err)
+		}
+		return store, nil
+	}
+	return nil, gerror.ErrStorageNotRegister
+}
+
+// closeMerkleTreeStore closes the embedded merkle tree stores.
+func closeMerkleTreeStore(mtStore interface{}) {
+	if store, ok := mtStore.(*kvstorage.KVStorage); ok {
+		if err := store.Close(); err != nil {
+			log.Error("error closing the merkle tree store. Error: ", err)
+		}
+	}
+}
+
+// getRegisteredNetworks returns the networks registered at runtime which are active, and the index of their exit trees.
+func getRegisteredNetworks(ctx context.Context, storage db.Storage, dbTx pgx.Tx) ([]uint, []uint8, error) {
+	networks, err := storage.(interface {
+		GetNetworks(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Network, error)
+	}).GetNetworks(ctx, dbTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		networkIDs []uint
+		tIDs       []uint8
+	)
+	for _, network := range networks {
+		if network.Active {
+			networkIDs = append(networkIDs, network.NetworkID)
+			tIDs = append(tIDs, network.TreeIndex)
+		}
 	}
+	return networkIDs, tIDs, nil
 }
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/jackc/pgx/v4"
+	"github.com/urfave/cli/v2"
+)
+
+const flagFile = "file"
+
+type snapshotStorage interface {
+	ExportSnapshot(ctx context.Context, w io.Writer) (*pgstorage.SnapshotManifest, error)
+	ImportSnapshot(ctx context.Context, r io.Reader, verify func(dbTx pgx.Tx) error) (*pgstorage.SnapshotManifest, error)
+	DeleteSnapshot(ctx context.Context) error
+}
+
+// newSnapshotStorage loads the config and opens the storage with the migrations applied.
+func newSnapshotStorage(ctx *cli.Context) (*config.Config, snapshotStorage, error) {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return nil, nil, err
+	}
+	setupLog(c.Log)
+	err = db.RunMigrations(c.Database)
+	if err != nil {
+		log.Error(err)
+		return nil, nil, err
+	}
+	storage, err := db.NewStorage(c.Database)
+	if err != nil {
+		log.Error(err)
+		return nil, nil, err
+	}
+	return c, storage.(snapshotStorage), nil
+}
+
+func exportSnapshot(ctx *cli.Context) error {
+	_, storage, err := newSnapshotStorage(ctx)
+	if err != nil {
+		return err
+	}
+	path := ctx.String(flagFile)
+	f, err := os.Create(path)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	manifest, err := storage.ExportSnapshot(ctx.Context, f)
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		log.Error(err)
+		return err
+	}
+	if err = f.Close(); err != nil {
+		log.Error(err)
+		return err
+	}
+	for networkID, blockNumber := range manifest.LastBlocks {
+		log.Infof("networkID: %d, snapshot exported at block %d", networkID, blockNumber)
+	}
+	log.Infof("snapshot exported to %s. Schema version: %s", path, manifest.SchemaVersion)
+	return nil
+}
+
+// importSnapshot restores the snapshot and verifies the exit trees against the restored deposits and exit roots.
+// The postgres trees are part of the snapshot, so they are verified before the import is committed. The embedded
+// merkle tree stores aren't part of the snapshot, so their trees are rebuilt once imported, and the imported rows
+// are removed if the trees can't be rebuilt.
+func importSnapshot(ctx *cli.Context) error {
+	c, storage, err := newSnapshotStorage(ctx)
+	if err != nil {
+		return err
+	}
+	rebuild := c.BridgeController.Store != storePostgres
+	var verify func(dbTx pgx.Tx) error
+	if !rebuild {
+		etherman, l2Ethermans, err := newEthermans(*c)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		networkIDs, err := getNetworkIDs(etherman, l2Ethermans)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		checker := bridgectrl.NewTreeChecker(c.BridgeController, storage, storage)
+		verify = func(dbTx pgx.Tx) error {
+			return verifyImportedTrees(ctx.Context, checker, storage, networkIDs, dbTx)
+		}
+	}
+
+	f, err := os.Open(ctx.String(flagFile))
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+	manifest, err := storage.ImportSnapshot(ctx.Context, f, verify)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	for networkID, blockNumber := range manifest.LastBlocks {
+		log.Infof("networkID: %d, snapshot imported, the synchronization continues from block %d", networkID, blockNumber)
+	}
+	if !rebuild {
+		return nil
+	}
+
+	if err = checkTrees(ctx, true, true); err != nil {
+		log.Warn("the exit trees can't be rebuilt from the imported snapshot, removing it")
+		if deleteErr := storage.DeleteSnapshot(ctx.Context); deleteErr != nil {
+			log.Error("error removing the imported snapshot. Error: ", deleteErr)
+		}
+		return fmt.Errorf("the imported exit trees don't match the stored exit roots: %w", err)
+	}
+	return nil
+}
+
+// verifyImportedTrees verifies the exit trees of the config and registered networks restored inside dbTx.
+func verifyImportedTrees(ctx context.Context, checker *bridgectrl.TreeChecker, storage db.Storage, networkIDs []uint, dbTx pgx.Tx) error {
+	tIDs := make([]uint8, 0, len(networkIDs))
+	for i := range networkIDs {
+		tIDs = append(tIDs, uint8(i))
+	}
+	registeredNetworkIDs, registeredTIDs, err := getRegisteredNetworks(ctx, storage, dbTx)
+	if err != nil {
+		return err
+	}
+	networkIDs = append(networkIDs, registeredNetworkIDs...)
+	tIDs = append(tIDs, registeredTIDs...)
+
+	for i, networkID := range networkIDs {
+		report, err := checker.Verify(ctx, networkID, tIDs[i], dbTx)
+		if err != nil {
+			return err
+		}
+		if report.Divergence != nil {
+			return fmt.Errorf("networkID: %d, the imported exit tree diverges at deposit count %d: %s",
+				networkID, report.Divergence.DepositCount, report.Divergence.Reason)
+		}
+		log.Infof("networkID: %d, the imported exit tree is consistent. Deposits: %d, root: %s", networkID, report.DepositCount, report.Root.String())
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/config"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/db"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/urfave/cli/v2"
+)
+
+const flagFix = "fix"
+
+func verifyTree(ctx *cli.Context) error {
+	return checkTrees(ctx, ctx.Bool(flagFix), false)
+}
+
+func rebuildTree(ctx *cli.Context) error {
+	return checkTrees(ctx, true, true)
+}
+
+// checkTrees verifies the exit tree of every network. If fix is true the diverging trees are rebuilt,
+// and if force is true all the trees are rebuilt.
+func checkTrees(ctx *cli.Context, fix, force bool) error {
+	c, err := config.Load(ctx.String(flagCfg), ctx.String(flagNetwork))
+	if err != nil {
+		return err
+	}
+	setupLog(c.Log)
+	err = db.RunMigrations(c.Database)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	etherman, l2Ethermans, err := newEthermans(*c)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	networkIDs, err := getNetworkIDs(etherman, l2Ethermans)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	storage, err := db.NewStorage(c.Database)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	mtStore, err := newMerkleTreeStore(c.BridgeController, storage)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	defer closeMerkleTreeStore(mtStore)
+
+	tIDs := make([]uint8, 0, len(networkIDs))
+	for i := range networkIDs {
+		tIDs = append(tIDs, uint8(i))
+	}
+	registeredNetworkIDs, registeredTIDs, err := getRegisteredNetworks(ctx.Context, storage, nil)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	networkIDs = append(networkIDs, registeredNetworkIDs...)
+	tIDs = append(tIDs, registeredTIDs...)
+
+	checker := bridgectrl.NewTreeChecker(c.BridgeController, storage, mtStore)
+	var diverged bool
+	for i, networkID := range networkIDs {
+		report, err := checker.Verify(ctx.Context, networkID, tIDs[i], nil)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		if report.Divergence == nil {
+			log.Infof("networkID: %d, the exit tree is consistent. Deposits: %d, root: %s", networkID, report.DepositCount, report.Root.String())
+		} else {
+			log.Warnf("networkID: %d, the exit tree diverges at deposit count %d: %s. Stored root: %s, expected root: %s",
+				networkID, report.Divergence.DepositCount, report.Divergence.Reason, report.Divergence.StoredRoot.String(), report.Divergence.ExpectedRoot.String())
+		}
+		if !force && (!fix || report.Divergence == nil) {
+			diverged = diverged || report.Divergence != nil
+			continue
+		}
+
+		log.Infof("networkID: %d, rebuilding the exit tree", networkID)
+		err = checker.Rebuild(ctx.Context, networkID, tIDs[i])
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		report, err = checker.Verify(ctx.Context, networkID, tIDs[i], nil)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		if report.Divergence != nil {
+			// The stored tree can only diverge from the deposits because of the exit root
+			log.Warnf("networkID: %d, the rebuilt exit tree diverges at deposit count %d: %s", networkID, report.Divergence.DepositCount, report.Divergence.Reason)
+			diverged = true
+			continue
+		}
+		log.Infof("networkID: %d, the exit tree is rebuilt. Deposits: %d, root: %s", networkID, report.DepositCount, report.Root.String())
+	}
+	if diverged {
+		return fmt.Errorf("some exit trees diverge from the stored deposits")
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/server"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/synchronizer"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/webhook"
 	"github.com/0xPolygonHermez/zkevm-node/log"
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
@@ -24,6 +25,7 @@ type Config struct {
 	Synchronizer     synchronizer.Config
 	BridgeController bridgectrl.Config
 	BridgeServer     server.Config
+	Webhook          webhook.Config
 	NetworkConfig
 }
 
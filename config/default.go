@@ -28,9 +28,22 @@ GrpcURL = "localhost:61090"
 
 [BridgeController]
 Store = "postgres"
+StorePath = "./merkletree.db"
 Height = 32
+GCInterval = "1h"
+	[BridgeController.ProofCache]
+	Size = 10000
 
 [BridgeServer]
 GRPCPort = "9090"
 HTTPPort = "8080"
+AdminToken = ""
+
+[Webhook]
+Workers = 0
+PollInterval = "1s"
+Timeout = "10s"
+MaxAttempts = 10
+InitialBackoff = "10s"
+MaxBackoff = "1h"
 `
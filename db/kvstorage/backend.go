@@ -0,0 +1,41 @@
+package kvstorage
+
+import "bytes"
+
+var (
+	// nodesBucket keeps the merkle tree nodes by their hash
+	nodesBucket = []byte("rht")
+	// rootsBucket keeps the roots of every network by deposit count
+	rootsBucket = []byte("root")
+	// rootIndexBucket keeps the deposit count of every root of every network
+	rootIndexBucket = []byte("root_index")
+
+	buckets = [][]byte{nodesBucket, rootsBucket, rootIndexBucket}
+
+	// lastKey is greater than every key of the buckets, the longest ones are the 33 bytes keys of rootIndexBucket
+	lastKey = bytes.Repeat([]byte{0xff}, 34) //nolint:gomnd
+)
+
+// kvBackend is the embedded key-value store used by KVStorage. The writes done in an Update are atomic.
+type kvBackend interface {
+	View(fn func(r kvReader) error) error
+	Update(fn func(w kvWriter) error) error
+	Close() error
+}
+
+// kvReader reads the keys of a bucket. The returned keys and values are only valid inside the View or Update function.
+type kvReader interface {
+	// Get returns nil if the key doesn't exist
+	Get(bucket, key []byte) []byte
+	// Ascend calls fn for every key between from and to, both included, in ascending order until fn returns false
+	Ascend(bucket, from, to []byte, fn func(key, value []byte) bool)
+	// Last returns the greatest key between from and to, both included, or nil if there isn't any
+	Last(bucket, from, to []byte) ([]byte, []byte)
+}
+
+// kvWriter writes the keys of a bucket.
+type kvWriter interface {
+	kvReader
+	Put(bucket, key, value []byte) error
+	Delete(bucket, key []byte) error
+}
@@ -0,0 +1,92 @@
+package kvstorage
+
+import (
+	"bytes"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// boltOpenTimeout is the time to wait for the file lock, it is held by the process which has the database open
+const boltOpenTimeout = 5 * time.Second
+
+// boltBackend keeps the buckets in a bbolt database file.
+type boltBackend struct {
+	db *bolt.DB
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+// NewBoltStorage creates a new KVStorage which keeps the merkle trees in the bbolt database file of the path.
+func NewBoltStorage(path string) (*KVStorage, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout}) //nolint:gomnd
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return newKVStorage(&boltBackend{db: db}), nil
+}
+
+func (b *boltBackend) View(fn func(r kvReader) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+func (b *boltBackend) Update(fn func(w kvWriter) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx: tx})
+	})
+}
+
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+func (tx boltTx) Get(bucket, key []byte) []byte {
+	return tx.tx.Bucket(bucket).Get(key)
+}
+
+func (tx boltTx) Ascend(bucket, from, to []byte, fn func(key, value []byte) bool) {
+	c := tx.tx.Bucket(bucket).Cursor()
+	for k, v := c.Seek(from); k != nil && bytes.Compare(k, to) <= 0; k, v = c.Next() {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+func (tx boltTx) Last(bucket, from, to []byte) ([]byte, []byte) {
+	c := tx.tx.Bucket(bucket).Cursor()
+	k, v := c.Seek(to)
+	if k == nil {
+		k, v = c.Last()
+	} else if !bytes.Equal(k, to) {
+		k, v = c.Prev()
+	}
+	if k == nil || bytes.Compare(k, from) < 0 {
+		return nil, nil
+	}
+	return k, v
+}
+
+func (tx boltTx) Put(bucket, key, value []byte) error {
+	return tx.tx.Bucket(bucket).Put(key, value)
+}
+
+func (tx boltTx) Delete(bucket, key []byte) error {
+	return tx.tx.Bucket(bucket).Delete(key)
+}
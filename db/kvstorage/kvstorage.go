@@ -0,0 +1,547 @@
+package kvstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
+)
+
+// KVStorage implements the merkle tree storage on top of an embedded key-value store.
+// The store is not part of the db transactions, so the changes written inside a db transaction are kept in memory,
+// visible only to the reads done inside the same transaction, until CommitTx is called once it is committed.
+type KVStorage struct {
+	db kvBackend
+
+	mu      sync.RWMutex
+	pending map[pgx.Tx]*txBatch
+	// written keeps the nodes written since the last search of unreachable nodes, they are not removed
+	written map[string]struct{}
+}
+
+// txBatch keeps the changes written inside a db transaction.
+type txBatch struct {
+	nodes map[string][]byte
+	// roots keeps the new roots of every network by deposit count
+	roots map[uint8]map[uint][]byte
+	// resets keeps the lowest deposit count every network is reset to, the stored roots above it are removed
+	resets map[uint8]uint
+}
+
+func newKVStorage(db kvBackend) *KVStorage {
+	return &KVStorage{
+		db:      db,
+		pending: make(map[pgx.Tx]*txBatch),
+		written: make(map[string]struct{}),
+	}
+}
+
+// Close closes the key-value store.
+func (s *KVStorage) Close() error {
+	return s.db.Close()
+}
+
+// batch returns the changes written inside dbTx. If create is false and there are no changes, nil is returned.
+// The caller must hold the lock.
+func (s *KVStorage) batch(dbTx pgx.Tx, create bool) *txBatch {
+	if dbTx == nil {
+		return nil
+	}
+	b := s.pending[dbTx]
+	if b == nil && create {
+		b = &txBatch{
+			nodes:  make(map[string][]byte),
+			roots:  make(map[uint8]map[uint][]byte),
+			resets: make(map[uint8]uint),
+		}
+		s.pending[dbTx] = b
+	}
+	return b
+}
+
+// CommitTx writes the changes done inside dbTx to the store. It must be called once dbTx is committed.
+func (s *KVStorage) CommitTx(ctx context.Context, dbTx pgx.Tx) error {
+	s.mu.Lock()
+	b := s.pending[dbTx]
+	delete(s.pending, dbTx)
+	if b != nil {
+		for key := range b.nodes {
+			s.written[key] = struct{}{}
+		}
+	}
+	s.mu.Unlock()
+	if b == nil {
+		return nil
+	}
+
+	return s.db.Update(func(w kvWriter) error {
+		for network, depositCnt := range b.resets {
+			err := resetRoots(w, network, depositCnt)
+			if err != nil {
+				return err
+			}
+		}
+		for key, value := range b.nodes {
+			err := w.Put(nodesBucket, []byte(key), value)
+			if err != nil {
+				return err
+			}
+		}
+		for network, roots := range b.roots {
+			for depositCnt, root := range roots {
+				err := putRoot(w, network, depositCnt, root)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// DiscardTx removes the changes done inside dbTx. It must be called if dbTx is rolled back.
+func (s *KVStorage) DiscardTx(dbTx pgx.Tx) {
+	s.mu.Lock()
+	delete(s.pending, dbTx)
+	s.mu.Unlock()
+}
+
+// Get gets value of key from the merkle tree.
+func (s *KVStorage) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if b := s.batch(dbTx, false); b != nil {
+		if data, found := b.nodes[string(key)]; found {
+			return decodeValue(data)
+		}
+	}
+
+	var data []byte
+	err := s.db.View(func(r kvReader) error {
+		data = copyBytes(r.Get(nodesBucket, key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return decodeValue(data)
+}
+
+// Set inserts a key-value pair into the db.
+func (s *KVStorage) Set(ctx context.Context, key []byte, value [][]byte, dbTx pgx.Tx) error {
+	return s.BulkSet(ctx, [][]byte{key}, [][][]byte{value}, dbTx)
+}
+
+// BulkSet inserts multiple key-value pairs into the db at once.
+func (s *KVStorage) BulkSet(ctx context.Context, keys [][]byte, values [][][]byte, dbTx pgx.Tx) error {
+	if len(keys) != len(values) {
+		return fmt.Errorf("mismatched number of keys and values: %d, %d", len(keys), len(values))
+	}
+	if dbTx == nil {
+		s.mu.Lock()
+		for i := range keys {
+			s.written[string(keys[i])] = struct{}{}
+		}
+		s.mu.Unlock()
+		return s.db.Update(func(w kvWriter) error {
+			for i := range keys {
+				err := w.Put(nodesBucket, copyBytes(keys[i]), encodeValue(values[i]))
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.batch(dbTx, true)
+	for i := range keys {
+		b.nodes[string(keys[i])] = encodeValue(values[i])
+	}
+	return nil
+}
+
+// ResetMT resets nodes of the Merkle Tree.
+func (s *KVStorage) ResetMT(ctx context.Context, depositCnt uint, network uint8, dbTx pgx.Tx) error {
+	if dbTx == nil {
+		return s.db.Update(func(w kvWriter) error {
+			return resetRoots(w, network, depositCnt)
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.batch(dbTx, true)
+	for cnt := range b.roots[network] {
+		if cnt > depositCnt {
+			delete(b.roots[network], cnt)
+		}
+	}
+	if resetCnt, found := b.resets[network]; !found || depositCnt < resetCnt {
+		b.resets[network] = depositCnt
+	}
+	return nil
+}
+
+// GetRoot gets root by the deposit count from the merkle tree.
+func (s *KVStorage) GetRoot(ctx context.Context, depositCnt uint, network uint8, dbTx pgx.Tx) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if b := s.batch(dbTx, false); b != nil {
+		if root, found := b.roots[network][depositCnt]; found {
+			return copyBytes(root), nil
+		}
+		if resetCnt, found := b.resets[network]; found && depositCnt > resetCnt {
+			return nil, gerror.ErrStorageNotFound
+		}
+	}
+
+	var root []byte
+	err := s.db.View(func(r kvReader) error {
+		root = copyBytes(r.Get(rootsBucket, rootKey(network, depositCnt)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return root, nil
+}
+
+// SetRoot store the root with deposit count to the storage.
+func (s *KVStorage) SetRoot(ctx context.Context, root []byte, depositCnt uint, network uint8, dbTx pgx.Tx) error {
+	return s.BulkSetRoot(ctx, [][]byte{root}, []uint{depositCnt}, network, dbTx)
+}
+
+// BulkSetRoot stores multiple roots with their deposit counts to the storage.
+func (s *KVStorage) BulkSetRoot(ctx context.Context, roots [][]byte, depositCnts []uint, network uint8, dbTx pgx.Tx) error {
+	if len(roots) != len(depositCnts) {
+		return fmt.Errorf("mismatched number of roots and deposit counts: %d, %d", len(roots), len(depositCnts))
+	}
+	if dbTx == nil {
+		return s.db.Update(func(w kvWriter) error {
+			for i := range roots {
+				err := putRoot(w, network, depositCnts[i], roots[i])
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.batch(dbTx, true)
+	if b.roots[network] == nil {
+		b.roots[network] = make(map[uint][]byte)
+	}
+	for i := range roots {
+		b.roots[network][depositCnts[i]] = copyBytes(roots[i])
+	}
+	return nil
+}
+
+// GetDepositCountByRoot gets the deposit count by the root.
+func (s *KVStorage) GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b := s.batch(dbTx, false)
+	if b != nil {
+		for depositCnt, r := range b.roots[network] {
+			if bytes.Equal(r, root) {
+				return depositCnt, nil
+			}
+		}
+	}
+
+	var (
+		depositCnt uint
+		found      bool
+	)
+	err := s.db.View(func(r kvReader) error {
+		value := r.Get(rootIndexBucket, rootIndexKey(network, root))
+		if value != nil {
+			depositCnt, found = uint(binary.BigEndian.Uint64(value)), true
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, gerror.ErrStorageNotFound
+	}
+	if b != nil {
+		if resetCnt, reset := b.resets[network]; reset && depositCnt > resetCnt {
+			return 0, gerror.ErrStorageNotFound
+		}
+	}
+	return depositCnt, nil
+}
+
+// GetRoots gets the roots of the network whose deposit count is greater than fromDepositCnt and not greater than
+// toDepositCnt, ordered by deposit count.
+func (s *KVStorage) GetRoots(ctx context.Context, network uint8, fromDepositCnt uint, toDepositCnt uint, dbTx pgx.Tx) ([]uint, [][]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	found := make(map[uint][]byte)
+	// The stored roots above the reset deposit count are removed inside dbTx
+	storedToDepositCnt := toDepositCnt
+	b := s.batch(dbTx, false)
+	if b != nil {
+		if resetCnt, reset := b.resets[network]; reset && resetCnt < storedToDepositCnt {
+			storedToDepositCnt = resetCnt
+		}
+	}
+	if fromDepositCnt < storedToDepositCnt {
+		err := s.db.View(func(r kvReader) error {
+			r.Ascend(rootsBucket, rootKey(network, fromDepositCnt+1), rootKey(network, storedToDepositCnt), func(key, value []byte) bool {
+				found[uint(binary.BigEndian.Uint64(key[1:]))] = copyBytes(value)
+				return true
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if b != nil {
+		for depositCnt, root := range b.roots[network] {
+			if depositCnt > fromDepositCnt && depositCnt <= toDepositCnt {
+				found[depositCnt] = copyBytes(root)
+			}
+		}
+	}
+
+	depositCnts := make([]uint, 0, len(found))
+	for depositCnt := range found {
+		depositCnts = append(depositCnts, depositCnt)
+	}
+	sort.Slice(depositCnts, func(i, j int) bool { return depositCnts[i] < depositCnts[j] })
+	roots := make([][]byte, 0, len(depositCnts))
+	for _, depositCnt := range depositCnts {
+		roots = append(roots, found[depositCnt])
+	}
+	return depositCnts, roots, nil
+}
+
+// GetLastDepositCount gets the last deposit count from the merkle tree.
+func (s *KVStorage) GetLastDepositCount(ctx context.Context, network uint8, dbTx pgx.Tx) (uint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var (
+		depositCnt uint
+		found      bool
+		last       = uint(math.MaxUint64)
+	)
+	b := s.batch(dbTx, false)
+	if b != nil {
+		if resetCnt, reset := b.resets[network]; reset {
+			last = resetCnt
+		}
+		for cnt := range b.roots[network] {
+			if !found || cnt > depositCnt {
+				depositCnt, found = cnt, true
+			}
+		}
+	}
+
+	err := s.db.View(func(r kvReader) error {
+		key, _ := r.Last(rootsBucket, rootKey(network, 0), rootKey(network, last))
+		if key != nil {
+			if cnt := uint(binary.BigEndian.Uint64(key[1:])); !found || cnt > depositCnt {
+				depositCnt, found = cnt, true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return depositCnt, nil
+}
+
+// GetUnreachableNodes gets the merkle tree nodes which are not reachable from any stored root of any network. The
+// versions are always zero, the nodes written again after the search are tracked by the store instead.
+func (s *KVStorage) GetUnreachableNodes(ctx context.Context, dbTx pgx.Tx) ([][]byte, []uint64, error) {
+	s.mu.Lock()
+	s.written = make(map[string]struct{})
+	s.mu.Unlock()
+
+	var keys [][]byte
+	err := s.db.View(func(r kvReader) error {
+		reachable := make(map[string]struct{})
+		var next [][]byte
+		r.Ascend(rootsBucket, []byte{}, lastKey, func(key, value []byte) bool {
+			next = append(next, copyBytes(value))
+			return true
+		})
+		for len(next) > 0 {
+			key := next[len(next)-1]
+			next = next[:len(next)-1]
+			if _, found := reachable[string(key)]; found {
+				continue
+			}
+			reachable[string(key)] = struct{}{}
+			data := r.Get(nodesBucket, key)
+			if data == nil {
+				continue
+			}
+			value, err := decodeValue(data)
+			if err != nil {
+				return err
+			}
+			next = append(next, value...)
+		}
+		r.Ascend(nodesBucket, []byte{}, lastKey, func(key, value []byte) bool {
+			if _, found := reachable[string(key)]; !found {
+				keys = append(keys, copyBytes(key))
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return keys, make([]uint64, len(keys)), nil
+}
+
+// DeleteNodes removes the merkle tree nodes unless they have been written again since they were found by
+// GetUnreachableNodes, and returns the number of removed nodes. The changes of the db transactions which are not
+// committed yet are kept too.
+func (s *KVStorage) DeleteNodes(ctx context.Context, keys [][]byte, versions []uint64, dbTx pgx.Tx) (uint64, error) {
+	if len(keys) != len(versions) {
+		return 0, fmt.Errorf("mismatched number of keys and versions: %d, %d", len(keys), len(versions))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var count uint64
+	err := s.db.Update(func(w kvWriter) error {
+		count = 0
+		for _, key := range keys {
+			if !s.removable(key) || w.Get(nodesBucket, key) == nil {
+				continue
+			}
+			err := w.Delete(nodesBucket, key)
+			if err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// removable returns whether the node hasn't been written since the last search of unreachable nodes. The caller must
+// hold the lock.
+func (s *KVStorage) removable(key []byte) bool {
+	if _, found := s.written[string(key)]; found {
+		return false
+	}
+	for _, b := range s.pending {
+		if _, found := b.nodes[string(key)]; found {
+			return false
+		}
+	}
+	return true
+}
+
+// putRoot writes the root of the network with its deposit count.
+func putRoot(w kvWriter, network uint8, depositCnt uint, root []byte) error {
+	err := w.Put(rootsBucket, rootKey(network, depositCnt), copyBytes(root))
+	if err != nil {
+		return err
+	}
+	value := make([]byte, 8) //nolint:gomnd
+	binary.BigEndian.PutUint64(value, uint64(depositCnt))
+	return w.Put(rootIndexBucket, rootIndexKey(network, root), value)
+}
+
+// resetRoots removes the roots of the network whose deposit count is greater than depositCnt.
+func resetRoots(w kvWriter, network uint8, depositCnt uint) error {
+	if depositCnt == math.MaxUint64 {
+		return nil
+	}
+	var keys, roots [][]byte
+	w.Ascend(rootsBucket, rootKey(network, depositCnt+1), rootKey(network, math.MaxUint64), func(key, value []byte) bool {
+		keys = append(keys, copyBytes(key))
+		roots = append(roots, copyBytes(value))
+		return true
+	})
+	for i := range keys {
+		err := w.Delete(rootsBucket, keys[i])
+		if err != nil {
+			return err
+		}
+		err = w.Delete(rootIndexBucket, rootIndexKey(network, roots[i]))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rootKey is the network followed by the big endian deposit count, so the roots of a network are ordered.
+func rootKey(network uint8, depositCnt uint) []byte {
+	key := make([]byte, 9) //nolint:gomnd
+	key[0] = network
+	binary.BigEndian.PutUint64(key[1:], uint64(depositCnt))
+	return key
+}
+
+func rootIndexKey(network uint8, root []byte) []byte {
+	return append([]byte{network}, root...)
+}
+
+// encodeValue concatenates the children of a node, each one prefixed with its length.
+func encodeValue(value [][]byte) []byte {
+	var (
+		data []byte
+		buf  [binary.MaxVarintLen64]byte
+	)
+	for _, v := range value {
+		n := binary.PutUvarint(buf[:], uint64(len(v)))
+		data = append(data, buf[:n]...)
+		data = append(data, v...)
+	}
+	return data
+}
+
+func decodeValue(data []byte) ([][]byte, error) {
+	var value [][]byte
+	for len(data) > 0 {
+		l, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < l {
+			return nil, fmt.Errorf("invalid merkle tree node value")
+		}
+		value = append(value, copyBytes(data[n:n+int(l)]))
+		data = data[n+int(l):]
+	}
+	return value, nil
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
@@ -0,0 +1,127 @@
+package kvstorage
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTx only identifies a db transaction, the store doesn't use it
+type testTx struct {
+	pgx.Tx
+}
+
+func TestKVStorage(t *testing.T) {
+	bolt, err := NewBoltStorage(filepath.Join(t.TempDir(), "mt.db"))
+	require.NoError(t, err)
+	defer bolt.Close() //nolint:errcheck
+
+	for name, store := range map[string]*KVStorage{"memory": NewMemoryStorage(), "bbolt": bolt} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			root := func(i int) []byte { return common.BigToHash(new(big.Int).Lsh(big.NewInt(1), uint(i))).Bytes() }
+
+			_, err := store.GetLastDepositCount(ctx, 0, nil)
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+			key := root(100)
+			value := [][]byte{root(101), root(102)}
+			require.NoError(t, store.Set(ctx, key, value, nil))
+			stored, err := store.Get(ctx, key, nil)
+			require.NoError(t, err)
+			assert.Equal(t, value, stored)
+			_, err = store.Get(ctx, root(103), nil)
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+			require.NoError(t, store.BulkSetRoot(ctx, [][]byte{root(0), root(1), root(2)}, []uint{0, 1, 2}, 0, nil))
+			require.NoError(t, store.SetRoot(ctx, root(10), 5, 1, nil))
+
+			// The changes written inside a transaction are only visible inside it until it is committed
+			dbTx := &testTx{}
+			require.NoError(t, store.ResetMT(ctx, 1, 0, dbTx))
+			require.NoError(t, store.BulkSetRoot(ctx, [][]byte{root(3), root(4)}, []uint{2, 3}, 0, dbTx))
+			require.NoError(t, store.BulkSet(ctx, [][]byte{root(104)}, [][][]byte{value}, dbTx))
+
+			_, err = store.Get(ctx, root(104), nil)
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+			_, err = store.Get(ctx, root(104), dbTx)
+			require.NoError(t, err)
+			depositCnt, err := store.GetLastDepositCount(ctx, 0, nil)
+			require.NoError(t, err)
+			assert.Equal(t, uint(2), depositCnt)
+			depositCnt, err = store.GetLastDepositCount(ctx, 0, dbTx)
+			require.NoError(t, err)
+			assert.Equal(t, uint(3), depositCnt)
+			_, err = store.GetDepositCountByRoot(ctx, root(2), 0, dbTx)
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+			depositCnt, err = store.GetDepositCountByRoot(ctx, root(2), 0, nil)
+			require.NoError(t, err)
+			assert.Equal(t, uint(2), depositCnt)
+			depositCnts, roots, err := store.GetRoots(ctx, 0, 0, 10, dbTx)
+			require.NoError(t, err)
+			assert.Equal(t, []uint{1, 2, 3}, depositCnts)
+			assert.Equal(t, [][]byte{root(1), root(3), root(4)}, roots)
+
+			// A discarded transaction doesn't change the store
+			store.DiscardTx(dbTx)
+			_, err = store.Get(ctx, root(104), dbTx)
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+			dbTx = &testTx{}
+			require.NoError(t, store.ResetMT(ctx, 1, 0, dbTx))
+			require.NoError(t, store.BulkSetRoot(ctx, [][]byte{root(3), root(4)}, []uint{2, 3}, 0, dbTx))
+			require.NoError(t, store.CommitTx(ctx, dbTx))
+
+			depositCnts, roots, err = store.GetRoots(ctx, 0, 0, 10, nil)
+			require.NoError(t, err)
+			assert.Equal(t, []uint{1, 2, 3}, depositCnts)
+			assert.Equal(t, [][]byte{root(1), root(3), root(4)}, roots)
+			_, err = store.GetDepositCountByRoot(ctx, root(2), 0, nil)
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+			storedRoot, err := store.GetRoot(ctx, 5, 1, nil)
+			require.NoError(t, err)
+			assert.Equal(t, root(10), storedRoot)
+
+			require.NoError(t, store.ResetMT(ctx, 0, 0, nil))
+			depositCnt, err = store.GetLastDepositCount(ctx, 0, nil)
+			require.NoError(t, err)
+			assert.Equal(t, uint(0), depositCnt)
+			depositCnt, err = store.GetLastDepositCount(ctx, 1, nil)
+			require.NoError(t, err)
+			assert.Equal(t, uint(5), depositCnt)
+
+			// The unreachable nodes are removed unless they are written again after they are found
+			require.NoError(t, store.Set(ctx, root(0), [][]byte{root(100), root(105)}, nil))
+			require.NoError(t, store.BulkSet(ctx, [][]byte{root(106), root(107), root(108)}, [][][]byte{value, value, value}, nil))
+			keys, versions, err := store.GetUnreachableNodes(ctx, nil)
+			require.NoError(t, err)
+			assert.Equal(t, [][]byte{root(106), root(107), root(108)}, keys)
+			dbTx = &testTx{}
+			require.NoError(t, store.Set(ctx, root(107), value, dbTx))
+			require.NoError(t, store.Set(ctx, root(108), value, nil))
+			count, err := store.DeleteNodes(ctx, keys, versions, nil)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(1), count)
+			_, err = store.Get(ctx, root(106), nil)
+			require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+			_, err = store.Get(ctx, root(107), nil)
+			require.NoError(t, err)
+			_, err = store.Get(ctx, root(100), nil)
+			require.NoError(t, err)
+
+			store.DiscardTx(dbTx)
+			keys, versions, err = store.GetUnreachableNodes(ctx, nil)
+			require.NoError(t, err)
+			count, err = store.DeleteNodes(ctx, keys, versions, nil)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(2), count)
+		})
+	}
+}
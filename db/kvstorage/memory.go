@@ -0,0 +1,116 @@
+package kvstorage
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// memoryBackend keeps the buckets in memory. It is meant for tests and tools, nothing survives a restart.
+type memoryBackend struct {
+	mu      sync.RWMutex
+	buckets map[string]map[string][]byte
+}
+
+// memoryTx reads and writes the buckets of an update. The modified buckets are copied the first time they are
+// written, so a failed update doesn't leave partial changes.
+type memoryTx struct {
+	buckets map[string]map[string][]byte
+	copied  map[string]bool
+}
+
+// NewMemoryStorage creates a new KVStorage which keeps the merkle trees in memory.
+func NewMemoryStorage() *KVStorage {
+	b := &memoryBackend{
+		buckets: make(map[string]map[string][]byte),
+	}
+	for _, bucket := range buckets {
+		b.buckets[string(bucket)] = make(map[string][]byte)
+	}
+	return newKVStorage(b)
+}
+
+func (b *memoryBackend) View(fn func(r kvReader) error) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return fn(&memoryTx{buckets: b.buckets})
+}
+
+func (b *memoryBackend) Update(fn func(w kvWriter) error) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	tx := &memoryTx{
+		buckets: make(map[string]map[string][]byte, len(b.buckets)),
+		copied:  make(map[string]bool),
+	}
+	for name, bucket := range b.buckets {
+		tx.buckets[name] = bucket
+	}
+	err := fn(tx)
+	if err != nil {
+		return err
+	}
+	b.buckets = tx.buckets
+	return nil
+}
+
+func (b *memoryBackend) Close() error {
+	return nil
+}
+
+func (tx *memoryTx) Get(bucket, key []byte) []byte {
+	return tx.buckets[string(bucket)][string(key)]
+}
+
+// keys returns the keys of the bucket between from and to, both included, in ascending order.
+func (tx *memoryTx) keys(bucket, from, to []byte) []string {
+	var keys []string
+	for key := range tx.buckets[string(bucket)] {
+		if bytes.Compare([]byte(key), from) >= 0 && bytes.Compare([]byte(key), to) <= 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (tx *memoryTx) Ascend(bucket, from, to []byte, fn func(key, value []byte) bool) {
+	for _, key := range tx.keys(bucket, from, to) {
+		if !fn([]byte(key), tx.buckets[string(bucket)][key]) {
+			return
+		}
+	}
+}
+
+func (tx *memoryTx) Last(bucket, from, to []byte) ([]byte, []byte) {
+	keys := tx.keys(bucket, from, to)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	key := keys[len(keys)-1]
+	return []byte(key), tx.buckets[string(bucket)][key]
+}
+
+// writable returns the bucket to modify, copying it the first time it is modified in the update.
+func (tx *memoryTx) writable(bucket []byte) map[string][]byte {
+	name := string(bucket)
+	if !tx.copied[name] {
+		values := make(map[string][]byte, len(tx.buckets[name]))
+		for key, value := range tx.buckets[name] {
+			values[key] = value
+		}
+		tx.buckets[name] = values
+		tx.copied[name] = true
+	}
+	return tx.buckets[name]
+}
+
+func (tx *memoryTx) Put(bucket, key, value []byte) error {
+	tx.writable(bucket)[string(key)] = append([]byte{}, value...)
+	return nil
+}
+
+func (tx *memoryTx) Delete(bucket, key []byte) error {
+	delete(tx.writable(bucket), string(key))
+	return nil
+}
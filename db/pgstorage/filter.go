@@ -0,0 +1,105 @@
+package pgstorage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+)
+
+// sqlConditions builds the WHERE clause of a query from the conditions and their arguments.
+type sqlConditions struct {
+	conditions []string
+	args       []interface{}
+}
+
+// add appends a condition. The %s in the condition are replaced by the placeholders of the arguments.
+func (c *sqlConditions) add(condition string, args ...interface{}) {
+	placeholders := make([]interface{}, 0, len(args))
+	for _, arg := range args {
+		c.args = append(c.args, arg)
+		placeholders = append(placeholders, fmt.Sprintf("$%d", len(c.args)))
+	}
+	c.conditions = append(c.conditions, fmt.Sprintf(condition, placeholders...))
+}
+
+// where returns the WHERE clause, or an empty string if there are no conditions.
+func (c *sqlConditions) where() string {
+	if len(c.conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(c.conditions, " AND ")
+}
+
+// placeholder returns the placeholder of the next argument, used for the arguments after the WHERE clause.
+func (c *sqlConditions) placeholder(arg interface{}) string {
+	c.args = append(c.args, arg)
+	return fmt.Sprintf("$%d", len(c.args))
+}
+
+// depositConditions returns the conditions of the deposit filter over the deposit table as d and the block table as b.
+func depositConditions(filter *etherman.DepositFilter) *sqlConditions {
+	c := &sqlConditions{}
+	if filter == nil {
+		return c
+	}
+	if filter.DestinationAddress != nil {
+		c.add("d.dest_addr = %s", filter.DestinationAddress.Bytes())
+	}
+	if filter.Sender != nil {
+		c.add("d.sender = %s", filter.Sender.Bytes())
+	}
+	if filter.NetworkID != nil {
+		c.add("d.network_id = %s", *filter.NetworkID)
+	}
+	if filter.DestinationNetwork != nil {
+		c.add("d.dest_net = %s", *filter.DestinationNetwork)
+	}
+	if filter.OriginalNetwork != nil {
+		c.add("d.orig_net = %s", *filter.OriginalNetwork)
+	}
+	if filter.OriginalAddress != nil {
+		c.add("d.orig_addr = %s", filter.OriginalAddress.Bytes())
+	}
+	if filter.LeafType != nil {
+		c.add("d.leaf_type = %s", *filter.LeafType)
+	}
+	if filter.FromBlock != nil {
+		c.add("b.block_num >= %s", *filter.FromBlock)
+	}
+	if filter.ToBlock != nil {
+		c.add("b.block_num <= %s", *filter.ToBlock)
+	}
+	if filter.FromTime != nil {
+		c.add("b.received_at >= %s", *filter.FromTime)
+	}
+	if filter.ToTime != nil {
+		c.add("b.received_at <= %s", *filter.ToTime)
+	}
+
+	// The status of the deposits is read from the state of their lifecycle
+	switch filter.Status {
+	case etherman.DepositStatusPending:
+		c.add("d.state < %s", etherman.DepositStateClaimable)
+	case etherman.DepositStatusReadyForClaim:
+		c.add("d.state = %s", etherman.DepositStateClaimable)
+	case etherman.DepositStatusClaimed:
+		c.add("d.state = %s", etherman.DepositStateClaimed)
+	}
+	return c
+}
+
+// tokenWrappedConditions returns the conditions of the wrapped token filter over the token wrapped table.
+func tokenWrappedConditions(filter *etherman.TokenWrappedFilter) *sqlConditions {
+	c := &sqlConditions{}
+	if filter == nil {
+		return c
+	}
+	if filter.NetworkID != nil {
+		c.add("network_id = %s", *filter.NetworkID)
+	}
+	if filter.Symbol != "" {
+		c.add("UPPER(symbol) = UPPER(%s)", filter.Symbol)
+	}
+	return c
+}
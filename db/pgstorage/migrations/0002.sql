@@ -0,0 +1,16 @@
+-- +migrate Down
+DROP TABLE IF EXISTS mtv2.rollup_exit;
+
+-- +migrate Up
+-- The leaves of every rollup exit tree computed from the verified local exit roots, the local exit root of each rollup
+-- with its index in the tree. They are removed with the block where the batches were verified.
+CREATE TABLE mtv2.rollup_exit
+(
+    id               BIGSERIAL PRIMARY KEY,
+    rollup_exit_root BYTEA NOT NULL,
+    network_id       INTEGER NOT NULL,
+    rollup_index     INTEGER NOT NULL,
+    local_exit_root  BYTEA NOT NULL,
+    block_id         BIGINT NOT NULL REFERENCES syncv2.block (id) ON DELETE CASCADE,
+    UNIQUE (rollup_exit_root, network_id)
+);
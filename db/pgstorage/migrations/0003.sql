@@ -0,0 +1,15 @@
+-- +migrate Down
+DROP TABLE IF EXISTS syncv2.network;
+
+-- +migrate Up
+-- The L2 networks registered at runtime. The tree index of a network is kept when it is deregistered, so its exit
+-- tree is reused if it is registered again.
+CREATE TABLE syncv2.network
+(
+    network_id  INTEGER PRIMARY KEY,
+    rpc_url     VARCHAR NOT NULL,
+    bridge_addr BYTEA NOT NULL,
+    start_block BIGINT NOT NULL,
+    tree_index  INTEGER NOT NULL UNIQUE,
+    active      BOOLEAN NOT NULL DEFAULT TRUE
+);
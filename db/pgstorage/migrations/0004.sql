@@ -0,0 +1,18 @@
+-- +migrate Down
+DROP INDEX IF EXISTS syncv2.deposit_dest_addr_block_id_idx;
+DROP INDEX IF EXISTS syncv2.deposit_network_id_block_id_idx;
+DROP INDEX IF EXISTS syncv2.deposit_dest_net_block_id_idx;
+DROP INDEX IF EXISTS syncv2.deposit_orig_token_block_id_idx;
+DROP INDEX IF EXISTS syncv2.deposit_block_id_idx;
+DROP INDEX IF EXISTS syncv2.block_network_id_block_num_idx;
+DROP INDEX IF EXISTS syncv2.block_received_at_idx;
+
+-- +migrate Up
+-- Indexes of the GetBridges filters, the deposits are listed from the newest block
+CREATE INDEX deposit_dest_addr_block_id_idx ON syncv2.deposit (dest_addr, block_id DESC);
+CREATE INDEX deposit_network_id_block_id_idx ON syncv2.deposit (network_id, block_id DESC);
+CREATE INDEX deposit_dest_net_block_id_idx ON syncv2.deposit (dest_net, block_id DESC);
+CREATE INDEX deposit_orig_token_block_id_idx ON syncv2.deposit (orig_net, orig_addr, block_id DESC);
+CREATE INDEX deposit_block_id_idx ON syncv2.deposit (block_id DESC);
+CREATE INDEX block_network_id_block_num_idx ON syncv2.block (network_id, block_num);
+CREATE INDEX block_received_at_idx ON syncv2.block (received_at);
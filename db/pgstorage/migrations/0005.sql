@@ -0,0 +1,14 @@
+-- +migrate Down
+DROP INDEX IF EXISTS syncv2.deposit_dest_addr_keyset_idx;
+DROP INDEX IF EXISTS syncv2.deposit_keyset_idx;
+DROP INDEX IF EXISTS syncv2.claim_dest_addr_keyset_idx;
+CREATE INDEX deposit_dest_addr_block_id_idx ON syncv2.deposit (dest_addr, block_id DESC);
+CREATE INDEX deposit_block_id_idx ON syncv2.deposit (block_id DESC);
+
+-- +migrate Up
+-- Indexes of the keyset pagination, in the order of the deposit and claim lists
+DROP INDEX IF EXISTS syncv2.deposit_dest_addr_block_id_idx;
+DROP INDEX IF EXISTS syncv2.deposit_block_id_idx;
+CREATE INDEX deposit_dest_addr_keyset_idx ON syncv2.deposit (dest_addr, block_id DESC, network_id DESC, deposit_cnt DESC);
+CREATE INDEX deposit_keyset_idx ON syncv2.deposit (block_id DESC, network_id DESC, deposit_cnt DESC);
+CREATE INDEX claim_dest_addr_keyset_idx ON syncv2.claim (dest_addr, block_id DESC, network_id DESC, index DESC);
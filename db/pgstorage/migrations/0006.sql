@@ -0,0 +1,50 @@
+-- +migrate Down
+DROP SCHEMA IF EXISTS webhook CASCADE;
+
+-- +migrate Up
+CREATE SCHEMA webhook;
+
+-- The webhook subscriptions. The events of the deposits to the address are sent to the url, the network is the
+-- destination network of the deposits and NULL matches all of them.
+CREATE TABLE webhook.subscription
+(
+    id          BIGSERIAL PRIMARY KEY,
+    url         VARCHAR NOT NULL,
+    secret      VARCHAR NOT NULL,
+    address     BYTEA NOT NULL,
+    network_id  INTEGER,
+    event_types SMALLINT[] NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX subscription_address_idx ON webhook.subscription (address);
+
+-- The deliveries waiting to be sent, or to be retried after a failed attempt
+CREATE TABLE webhook.delivery
+(
+    id              BIGSERIAL PRIMARY KEY,
+    subscription_id BIGINT NOT NULL REFERENCES webhook.subscription (id) ON DELETE CASCADE,
+    event_type      SMALLINT NOT NULL,
+    payload         BYTEA NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_error      VARCHAR,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX delivery_next_attempt_at_idx ON webhook.delivery (next_attempt_at);
+
+-- The deliveries which failed all the attempts, they are sent again when replayed
+CREATE TABLE webhook.dead_letter
+(
+    id              BIGINT PRIMARY KEY,
+    subscription_id BIGINT NOT NULL REFERENCES webhook.subscription (id) ON DELETE CASCADE,
+    event_type      SMALLINT NOT NULL,
+    payload         BYTEA NOT NULL,
+    attempts        INTEGER NOT NULL,
+    last_error      VARCHAR,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    failed_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX dead_letter_subscription_id_idx ON webhook.dead_letter (subscription_id, id);
@@ -0,0 +1,9 @@
+-- +migrate Down
+DROP INDEX IF EXISTS syncv2.deposit_sender_keyset_idx;
+ALTER TABLE syncv2.deposit DROP COLUMN IF EXISTS sender;
+
+-- +migrate Up
+-- The sender of the bridge transaction. It is NULL for the deposits synced before this migration until they are
+-- backfilled from the transactions.
+ALTER TABLE syncv2.deposit ADD COLUMN sender BYTEA;
+CREATE INDEX deposit_sender_keyset_idx ON syncv2.deposit (sender, block_id DESC, network_id DESC, deposit_cnt DESC);
@@ -0,0 +1,8 @@
+-- +migrate Down
+DROP INDEX IF EXISTS syncv2.deposit_tx_hash_idx;
+DROP INDEX IF EXISTS syncv2.claim_tx_hash_idx;
+
+-- +migrate Up
+-- Indexes of the lookups by transaction hash
+CREATE INDEX deposit_tx_hash_idx ON syncv2.deposit (tx_hash);
+CREATE INDEX claim_tx_hash_idx ON syncv2.claim (tx_hash);
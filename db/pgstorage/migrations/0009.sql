@@ -0,0 +1,21 @@
+-- +migrate Down
+ALTER TABLE syncv2.claim DROP CONSTRAINT claim_pkey;
+ALTER TABLE syncv2.claim ADD PRIMARY KEY (network_id, index);
+ALTER TABLE syncv2.claim DROP COLUMN IF EXISTS source_net;
+
+-- +migrate Up
+-- The network of the claimed deposit. The claims of the rollups are proven against the mainnet exit root, so their
+-- deposits are in mainnet. The claims of mainnet are linked to the rollup deposit with the same leaf, and the ones
+-- without it are assumed to be of the first rollup, the only one of the first version of the contracts.
+ALTER TABLE syncv2.claim ADD COLUMN source_net INTEGER;
+UPDATE syncv2.claim SET source_net = 0 WHERE network_id <> 0;
+UPDATE syncv2.claim AS c SET source_net = (
+    SELECT d.network_id FROM syncv2.deposit AS d
+    WHERE d.dest_net = c.network_id AND d.deposit_cnt = c.index AND d.network_id <> c.network_id AND d.orig_net = c.orig_net
+        AND d.orig_addr = c.orig_addr AND d.dest_addr = c.dest_addr AND d.amount = c.amount
+    ORDER BY d.network_id LIMIT 1
+) WHERE c.network_id = 0;
+UPDATE syncv2.claim SET source_net = 1 WHERE source_net IS NULL;
+ALTER TABLE syncv2.claim ALTER COLUMN source_net SET NOT NULL;
+ALTER TABLE syncv2.claim DROP CONSTRAINT claim_pkey;
+ALTER TABLE syncv2.claim ADD PRIMARY KEY (network_id, source_net, index);
@@ -0,0 +1,65 @@
+-- +migrate Down
+DROP INDEX IF EXISTS syncv2.deposit_state_idx;
+ALTER TABLE syncv2.deposit DROP COLUMN IF EXISTS state;
+DROP TABLE IF EXISTS syncv2.deposit_transition;
+
+-- +migrate Up
+-- The current state of the lifecycle of every deposit, and the history of its transitions. The transitions are
+-- removed with the block where they were synced, and the ones without a block are the synced from the trusted state
+-- and the reorgs, observed_at is the time they were observed if it's known. The deposits are identified by their
+-- block too, so the deposits with the same count synced before and after a reorg aren't mixed. created_at is NULL
+-- for the transitions backfilled by this migration.
+CREATE TABLE syncv2.deposit_transition
+(
+    id               BIGSERIAL PRIMARY KEY,
+    network_id       INTEGER NOT NULL,
+    deposit_cnt      BIGINT NOT NULL,
+    deposit_block_id BIGINT NOT NULL,
+    state            SMALLINT NOT NULL,
+    block_id         BIGINT REFERENCES syncv2.block (id) ON DELETE CASCADE,
+    observed_at      TIMESTAMP WITH TIME ZONE,
+    created_at       TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+CREATE INDEX deposit_transition_deposit_idx ON syncv2.deposit_transition (network_id, deposit_cnt, deposit_block_id, id);
+CREATE INDEX deposit_transition_block_id_idx ON syncv2.deposit_transition (block_id);
+
+-- The states of the deposits synced before this migration are computed from the latest exit roots, so the next exit
+-- roots only move the new deposits. The mainnet deposits are claimable with the trusted exit root, and the rollup
+-- deposits with the exit root synced from L1, whose local exit roots are read from the rollup exit tree leaves. The
+-- batches of the rollup deposits are verified when they are included in the latest verified local exit roots.
+ALTER TABLE syncv2.deposit ADD COLUMN state SMALLINT NOT NULL DEFAULT 0;
+UPDATE syncv2.deposit SET state = 2;
+UPDATE syncv2.deposit AS d SET state = 3 FROM (
+    SELECT r.deposit_cnt FROM (SELECT exit_roots FROM syncv2.exit_root WHERE block_id > 0 ORDER BY id DESC LIMIT 1) AS e
+    INNER JOIN mtv2.root AS r ON r.root = e.exit_roots[1] AND r.network = 0
+) AS l WHERE d.network_id = 0 AND d.deposit_cnt < l.deposit_cnt;
+UPDATE syncv2.deposit AS d SET state = 5 FROM (
+    SELECT r.deposit_cnt FROM (SELECT exit_roots FROM syncv2.exit_root WHERE block_id = 0 ORDER BY id DESC LIMIT 1) AS e
+    INNER JOIN mtv2.root AS r ON r.root = e.exit_roots[1] AND r.network = 0
+) AS t WHERE d.network_id = 0 AND d.deposit_cnt < t.deposit_cnt;
+UPDATE syncv2.deposit AS d SET state = 4 FROM (
+    SELECT x.network_id, r.deposit_cnt FROM (SELECT rollup_exit_root FROM mtv2.rollup_exit ORDER BY id DESC LIMIT 1) AS v
+    INNER JOIN mtv2.rollup_exit AS x ON x.rollup_exit_root = v.rollup_exit_root
+    INNER JOIN mtv2.root AS r ON r.root = x.local_exit_root AND r.network <> 0
+) AS l WHERE d.network_id = l.network_id AND d.network_id <> 0 AND d.deposit_cnt < l.deposit_cnt;
+UPDATE syncv2.deposit AS d SET state = 5 FROM (
+    SELECT x.network_id, r.deposit_cnt FROM (SELECT exit_roots FROM syncv2.exit_root WHERE block_id > 0 ORDER BY id DESC LIMIT 1) AS e
+    INNER JOIN mtv2.rollup_exit AS x ON x.rollup_exit_root = e.exit_roots[2]
+    INNER JOIN mtv2.root AS r ON r.root = x.local_exit_root AND r.network <> 0
+) AS l WHERE d.network_id = l.network_id AND d.network_id <> 0 AND d.deposit_cnt < l.deposit_cnt;
+UPDATE syncv2.deposit AS d SET state = 6 WHERE EXISTS (
+    SELECT 1 FROM syncv2.claim AS c WHERE c.network_id = d.dest_net AND c.source_net = d.network_id AND c.index = d.deposit_cnt
+);
+CREATE INDEX deposit_state_idx ON syncv2.deposit (network_id, state, deposit_cnt);
+
+-- The history of the deposits synced before this migration starts with the states they reached. The states which
+-- depend on the exit roots have no block, so they are kept by the reorgs of the following blocks.
+INSERT INTO syncv2.deposit_transition (network_id, deposit_cnt, deposit_block_id, state, block_id, created_at)
+SELECT network_id, deposit_cnt, block_id, 2, block_id, NULL FROM syncv2.deposit;
+INSERT INTO syncv2.deposit_transition (network_id, deposit_cnt, deposit_block_id, state, created_at)
+SELECT d.network_id, d.deposit_cnt, d.block_id, s.state, NULL FROM syncv2.deposit AS d
+INNER JOIN (VALUES (3), (4), (5)) AS s(state) ON s.state <= d.state
+    AND (s.state = 5 OR (s.state = 3 AND d.network_id = 0) OR (s.state = 4 AND d.network_id <> 0));
+INSERT INTO syncv2.deposit_transition (network_id, deposit_cnt, deposit_block_id, state, block_id, created_at)
+SELECT d.network_id, d.deposit_cnt, d.block_id, 6, c.block_id, NULL FROM syncv2.deposit AS d
+INNER JOIN syncv2.claim AS c ON c.network_id = d.dest_net AND c.source_net = d.network_id AND c.index = d.deposit_cnt;
@@ -0,0 +1,6 @@
+-- +migrate Down
+DROP INDEX IF EXISTS syncv2.deposit_transition_state_idx;
+
+-- +migrate Up
+-- The latencies of the deposits are computed from the recent transitions to the claimable state.
+CREATE INDEX deposit_transition_state_idx ON syncv2.deposit_transition (state, created_at);
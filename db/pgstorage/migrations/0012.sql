@@ -0,0 +1,42 @@
+-- +migrate Down
+DROP TABLE IF EXISTS syncv2.claim_stats;
+DROP TABLE IF EXISTS syncv2.deposit_stats;
+
+-- +migrate Up
+-- Hourly rollups of the deposits and the claims of every token, updated by the synchronizer with each block and
+-- reverted by the reorgs. The tokens are identified by their original network and address.
+CREATE TABLE syncv2.deposit_stats
+(
+    network_id  INTEGER NOT NULL,
+    dest_net    INTEGER NOT NULL,
+    orig_net    INTEGER NOT NULL,
+    orig_addr   BYTEA NOT NULL,
+    hour        TIMESTAMP WITH TIME ZONE NOT NULL,
+    deposit_cnt BIGINT NOT NULL,
+    amount      NUMERIC NOT NULL,
+    PRIMARY KEY (network_id, dest_net, orig_net, orig_addr, hour)
+);
+CREATE INDEX deposit_stats_hour_idx ON syncv2.deposit_stats (hour);
+
+CREATE TABLE syncv2.claim_stats
+(
+    network_id INTEGER NOT NULL,
+    source_net INTEGER NOT NULL,
+    orig_net   INTEGER NOT NULL,
+    orig_addr  BYTEA NOT NULL,
+    hour       TIMESTAMP WITH TIME ZONE NOT NULL,
+    claim_cnt  BIGINT NOT NULL,
+    amount     NUMERIC NOT NULL,
+    PRIMARY KEY (network_id, source_net, orig_net, orig_addr, hour)
+);
+CREATE INDEX claim_stats_hour_idx ON syncv2.claim_stats (hour);
+
+INSERT INTO syncv2.deposit_stats (network_id, dest_net, orig_net, orig_addr, hour, deposit_cnt, amount)
+SELECT d.network_id, d.dest_net, d.orig_net, d.orig_addr, date_trunc('hour', b.received_at), COUNT(*), SUM(d.amount::NUMERIC)
+FROM syncv2.deposit AS d INNER JOIN syncv2.block AS b ON d.block_id = b.id
+GROUP BY d.network_id, d.dest_net, d.orig_net, d.orig_addr, date_trunc('hour', b.received_at);
+
+INSERT INTO syncv2.claim_stats (network_id, source_net, orig_net, orig_addr, hour, claim_cnt, amount)
+SELECT c.network_id, c.source_net, c.orig_net, c.orig_addr, date_trunc('hour', b.received_at), COUNT(*), SUM(c.amount::NUMERIC)
+FROM syncv2.claim AS c INNER JOIN syncv2.block AS b ON c.block_id = b.id
+GROUP BY c.network_id, c.source_net, c.orig_net, c.orig_addr, date_trunc('hour', b.received_at);
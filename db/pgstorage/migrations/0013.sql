@@ -0,0 +1,8 @@
+-- +migrate Down
+DROP INDEX IF EXISTS syncv2.token_wrapped_orig_idx;
+DROP INDEX IF EXISTS syncv2.token_wrapped_addr_idx;
+
+-- +migrate Up
+-- Indexes of the lookups of the wrapped tokens by their original token and by their address in each network.
+CREATE INDEX token_wrapped_orig_idx ON syncv2.token_wrapped (orig_net, orig_token_addr, network_id);
+CREATE INDEX token_wrapped_addr_idx ON syncv2.token_wrapped (wrapped_token_addr, network_id);
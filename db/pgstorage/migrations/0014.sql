@@ -0,0 +1,26 @@
+-- +migrate Down
+DROP TABLE IF EXISTS webhook.event;
+
+-- +migrate Up
+-- The outbox of the deposit events of the webhooks. The transitions of the deposits to the subscribed addresses are
+-- stored with them, in the same db transaction, and the dispatcher moves them to the deliveries of the matching
+-- subscriptions. The deposit is copied, as the deposits removed by a reorg are deleted before they are dispatched.
+CREATE TABLE webhook.event
+(
+    id            BIGSERIAL PRIMARY KEY,
+    state         SMALLINT NOT NULL,
+    leaf_type     INTEGER,
+    network_id    INTEGER,
+    orig_net      INTEGER,
+    orig_addr     BYTEA NOT NULL,
+    amount        VARCHAR,
+    dest_net      INTEGER NOT NULL,
+    dest_addr     BYTEA NOT NULL,
+    block_num     BIGINT NOT NULL,
+    deposit_cnt   BIGINT,
+    tx_hash       BYTEA NOT NULL,
+    metadata      BYTEA NOT NULL,
+    sender        BYTEA,
+    claim_tx_hash BYTEA,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
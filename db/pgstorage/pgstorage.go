@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
@@ -16,6 +15,9 @@ import (
 	"github.com/lib/pq"
 )
 
+// mtLockID is the advisory lock which coordinates the merkle tree node writers with the garbage collector.
+const mtLockID = 7274
+
 // PostgresStorage implements the Storage interface.
 type PostgresStorage struct {
 	*pgxpool.Pool
@@ -67,6 +69,11 @@ func (p *PostgresStorage) BeginDBTransaction(ctx context.Context) (pgx.Tx, error
 	return p.Begin(ctx)
 }
 
+// BeginSnapshotDBTransaction starts a read only transaction whose reads see the same snapshot of the database.
+func (p *PostgresStorage) BeginSnapshotDBTransaction(ctx context.Context) (pgx.Tx, error) {
+	return p.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+}
+
 // GetLastBlock gets the last block.
 func (p *PostgresStorage) GetLastBlock(ctx context.Context, networkID uint, dbTx pgx.Tx) (*etherman.Block, error) {
 	var block etherman.Block
@@ -161,17 +168,17 @@ func (p *PostgresStorage) AddGlobalExitRoot(ctx context.Context, exitRoot *ether
 
 // AddDeposit adds new deposit to the storage.
 func (p *PostgresStorage) AddDeposit(ctx context.Context, deposit *etherman.Deposit, dbTx pgx.Tx) error {
-	const addDepositSQL = "INSERT INTO syncv2.deposit (leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"
+	const addDepositSQL = "INSERT INTO syncv2.deposit (leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, sender) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)"
 	e := p.getExecQuerier(dbTx)
-	_, err := e.Exec(ctx, addDepositSQL, deposit.LeafType, deposit.NetworkID, deposit.OriginalNetwork, deposit.OriginalAddress, deposit.Amount.String(), deposit.DestinationNetwork, deposit.DestinationAddress, deposit.BlockID, deposit.DepositCount, deposit.TxHash, deposit.Metadata)
+	_, err := e.Exec(ctx, addDepositSQL, deposit.LeafType, deposit.NetworkID, deposit.OriginalNetwork, deposit.OriginalAddress, deposit.Amount.String(), deposit.DestinationNetwork, deposit.DestinationAddress, deposit.BlockID, deposit.DepositCount, deposit.TxHash, deposit.Metadata, deposit.Sender)
 	return err
 }
 
 // AddClaim adds new claim to the storage.
 func (p *PostgresStorage) AddClaim(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error {
-	const addClaimSQL = "INSERT INTO syncv2.claim (network_id, index, orig_net, orig_addr, amount, dest_addr, block_id, tx_hash) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	const addClaimSQL = "INSERT INTO syncv2.claim (network_id, index, orig_net, orig_addr, amount, dest_addr, block_id, tx_hash, source_net) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)"
 	e := p.getExecQuerier(dbTx)
-	_, err := e.Exec(ctx, addClaimSQL, claim.NetworkID, claim.Index, claim.OriginalNetwork, claim.OriginalAddress, claim.Amount.String(), claim.DestinationAddress, claim.BlockID, claim.TxHash)
+	_, err := e.Exec(ctx, addClaimSQL, claim.NetworkID, claim.Index, claim.OriginalNetwork, claim.OriginalAddress, claim.Amount.String(), claim.DestinationAddress, claim.BlockID, claim.TxHash, claim.SourceNetwork)
 	return err
 }
 
@@ -211,9 +218,21 @@ func (p *PostgresStorage) AddTokenWrapped(ctx context.Context, tokenWrapped *eth
 // Reset resets the state to a block for the given DB tx.
 func (p *PostgresStorage) Reset(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) error {
 	const resetSQL = "DELETE FROM syncv2.block WHERE block_num > $1 AND network_id = $2"
+	// The transitions synced in the removed blocks are removed with them, so the state of their deposits is reset
+	networks, depositCnts, blockIDs, err := p.depositsOfResetTransitions(ctx, blockNumber, networkID, dbTx)
+	if err != nil {
+		return err
+	}
+	// The statistics are reverted before the deposits and the claims are removed
+	if err = p.removeResetStats(ctx, blockNumber, networkID, dbTx); err != nil {
+		return err
+	}
 	e := p.getExecQuerier(dbTx)
-	_, err := e.Exec(ctx, resetSQL, blockNumber, networkID)
-	return err
+	_, err = e.Exec(ctx, resetSQL, blockNumber, networkID)
+	if err != nil || len(networks) == 0 {
+		return err
+	}
+	return p.resetDepositStates(ctx, networks, depositCnts, blockIDs, dbTx)
 }
 
 // GetPreviousBlock gets the offset previous L1 block respect to latest.
@@ -288,14 +307,14 @@ func (p *PostgresStorage) AddTrustedGlobalExitRoot(ctx context.Context, trustedE
 	return err
 }
 
-// GetClaim gets a specific claim from the storage.
-func (p *PostgresStorage) GetClaim(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+// GetClaim gets the claim in the network of the deposit of the source network with the deposit count.
+func (p *PostgresStorage) GetClaim(ctx context.Context, depositCounterUser uint, networkID uint, sourceNetworkID uint, dbTx pgx.Tx) (*etherman.Claim, error) {
 	var (
 		claim  etherman.Claim
 		amount string
 	)
-	const getClaimSQL = "SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, network_id, tx_hash FROM syncv2.claim WHERE index = $1 AND network_id = $2"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getClaimSQL, depositCounterUser, networkID).Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.NetworkID, &claim.TxHash)
+	const getClaimSQL = "SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, network_id, tx_hash, source_net FROM syncv2.claim WHERE index = $1 AND network_id = $2 AND source_net = $3"
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getClaimSQL, depositCounterUser, networkID, sourceNetworkID).Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.NetworkID, &claim.TxHash, &claim.SourceNetwork)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	}
@@ -303,18 +322,33 @@ func (p *PostgresStorage) GetClaim(ctx context.Context, depositCounterUser uint,
 	return &claim, err
 }
 
+// GetClaimedDepositNetwork gets the network of the deposit with the same leaf as the claim, excluding the network of
+// the claim.
+func (p *PostgresStorage) GetClaimedDepositNetwork(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (uint, error) {
+	const getClaimedDepositNetworkSQL = `SELECT network_id FROM syncv2.deposit WHERE dest_net = $1 AND deposit_cnt = $2 AND network_id <> $1
+		AND orig_net = $3 AND orig_addr = $4 AND dest_addr = $5 AND amount = $6 ORDER BY network_id LIMIT 1`
+	var networkID uint
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getClaimedDepositNetworkSQL, claim.NetworkID, claim.Index, claim.OriginalNetwork, claim.OriginalAddress, claim.DestinationAddress, claim.Amount.String()).Scan(&networkID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return networkID, err
+}
+
 // GetDeposit gets a specific deposit from the storage.
 func (p *PostgresStorage) GetDeposit(ctx context.Context, depositCounterUser uint, networkID uint, dbTx pgx.Tx) (*etherman.Deposit, error) {
 	var (
 		deposit etherman.Deposit
 		amount  string
+		sender  []byte
 	)
-	const getDepositSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE d.network_id = $1 AND deposit_cnt = $2"
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositSQL, networkID, depositCounterUser).Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata)
+	const getDepositSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, sender, b.received_at, d.state FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE d.network_id = $1 AND deposit_cnt = $2"
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositSQL, networkID, depositCounterUser).Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &sender, &deposit.ReceivedAt, &deposit.State)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
 	}
 	deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+	deposit.Sender = common.BytesToAddress(sender)
 
 	return &deposit, err
 }
@@ -364,39 +398,213 @@ func (p *PostgresStorage) GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx
 	return &ger, nil
 }
 
-// GetTokenWrapped gets a specific wrapped token.
-func (p *PostgresStorage) GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
-	const getWrappedTokenSQL = "SELECT network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals FROM syncv2.token_wrapped WHERE orig_net = $1 AND orig_token_addr = $2"
+// GetExitRootByGlobalExitRoot gets the exit roots of a specific global exit root.
+func (p *PostgresStorage) GetExitRootByGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	var (
+		ger       etherman.GlobalExitRoot
+		exitRoots [][]byte
+	)
+	const getExitRootByGERSQL = "SELECT block_id, global_exit_root, exit_roots FROM syncv2.exit_root WHERE global_exit_root = $1 ORDER BY id DESC LIMIT 1"
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getExitRootByGERSQL, globalExitRoot).Scan(&ger.BlockID, &ger.GlobalExitRoot, pq.Array(&exitRoots))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, gerror.ErrStorageNotFound
+		}
+		return nil, err
+	}
+	ger.ExitRoots = []common.Hash{common.BytesToHash(exitRoots[0]), common.BytesToHash(exitRoots[1])}
+	return &ger, nil
+}
+
+// AddRollupExitLeaves stores the verified local exit root of every rollup used to compute the rollup exit root in
+// the block where the batches were verified.
+func (p *PostgresStorage) AddRollupExitLeaves(ctx context.Context, rollupExitRoot common.Hash, leaves []*etherman.RollupExitLeaf, blockID uint64, dbTx pgx.Tx) error {
+	const addRollupExitLeafSQL = `INSERT INTO mtv2.rollup_exit (rollup_exit_root, network_id, rollup_index, local_exit_root, block_id)
+		VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+	batch := &pgx.Batch{}
+	for _, leaf := range leaves {
+		batch.Queue(addRollupExitLeafSQL, rollupExitRoot, leaf.NetworkID, leaf.RollupIndex, leaf.LocalExitRoot, blockID)
+	}
+	return p.getExecQuerier(dbTx).SendBatch(ctx, batch).Close()
+}
+
+// GetRollupExitLeaves gets the local exit root of every rollup used to compute the rollup exit root, ordered by the
+// rollup index.
+func (p *PostgresStorage) GetRollupExitLeaves(ctx context.Context, rollupExitRoot common.Hash, dbTx pgx.Tx) ([]*etherman.RollupExitLeaf, error) {
+	const getRollupExitLeavesSQL = "SELECT network_id, rollup_index, local_exit_root FROM mtv2.rollup_exit WHERE rollup_exit_root = $1 ORDER BY rollup_index"
+	return p.getRollupExitLeaves(ctx, getRollupExitLeavesSQL, dbTx, rollupExitRoot)
+}
+
+// GetLatestRollupExitLeaves gets the leaves of the latest stored rollup exit tree, ordered by the rollup index.
+func (p *PostgresStorage) GetLatestRollupExitLeaves(ctx context.Context, dbTx pgx.Tx) ([]*etherman.RollupExitLeaf, error) {
+	const getLatestRollupExitLeavesSQL = `SELECT network_id, rollup_index, local_exit_root FROM mtv2.rollup_exit
+		WHERE rollup_exit_root = (SELECT rollup_exit_root FROM mtv2.rollup_exit ORDER BY id DESC LIMIT 1) ORDER BY rollup_index`
+	return p.getRollupExitLeaves(ctx, getLatestRollupExitLeavesSQL, dbTx)
+}
+
+func (p *PostgresStorage) getRollupExitLeaves(ctx context.Context, sql string, dbTx pgx.Tx, args ...interface{}) ([]*etherman.RollupExitLeaf, error) {
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var leaves []*etherman.RollupExitLeaf
+	for rows.Next() {
+		var leaf etherman.RollupExitLeaf
+		err = rows.Scan(&leaf.NetworkID, &leaf.RollupIndex, &leaf.LocalExitRoot)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, &leaf)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return leaves, nil
+}
+
+// AddNetwork stores a network registered at runtime. If the network was registered before, it is activated again
+// with the new connection data and its tree index is kept.
+func (p *PostgresStorage) AddNetwork(ctx context.Context, network *etherman.Network, dbTx pgx.Tx) error {
+	const addNetworkSQL = `INSERT INTO syncv2.network (network_id, rpc_url, bridge_addr, start_block, tree_index, active) VALUES ($1, $2, $3, $4, $5, TRUE)
+		ON CONFLICT (network_id) DO UPDATE SET rpc_url = EXCLUDED.rpc_url, bridge_addr = EXCLUDED.bridge_addr, start_block = EXCLUDED.start_block, active = TRUE`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addNetworkSQL, network.NetworkID, network.RPCURL, network.BridgeAddr, network.StartBlock, network.TreeIndex)
+	return err
+}
+
+// GetNetworks gets the networks registered at runtime, including the deregistered ones.
+func (p *PostgresStorage) GetNetworks(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Network, error) {
+	const getNetworksSQL = "SELECT network_id, rpc_url, bridge_addr, start_block, tree_index, active FROM syncv2.network ORDER BY tree_index"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getNetworksSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var networks []*etherman.Network
+	for rows.Next() {
+		var network etherman.Network
+		err = rows.Scan(&network.NetworkID, &network.RPCURL, &network.BridgeAddr, &network.StartBlock, &network.TreeIndex, &network.Active)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, &network)
+	}
+	return networks, rows.Err()
+}
+
+// DeactivateNetwork marks a network registered at runtime as deregistered.
+func (p *PostgresStorage) DeactivateNetwork(ctx context.Context, networkID uint, dbTx pgx.Tx) error {
+	const deactivateNetworkSQL = "UPDATE syncv2.network SET active = FALSE WHERE network_id = $1"
+	tag, err := p.getExecQuerier(dbTx).Exec(ctx, deactivateNetworkSQL, networkID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return gerror.ErrStorageNotFound
+	}
+	return nil
+}
+
+const getTokenWrappedSQL = "SELECT network_id, orig_net, orig_token_addr, wrapped_token_addr, block_id, name, symbol, decimals FROM syncv2.token_wrapped"
+
+// GetTokenWrapped gets the wrapped token of the original token in the network, or in any network if it's nil.
+func (p *PostgresStorage) GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, networkID *uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	const getWrappedTokenSQL = getTokenWrappedSQL + " WHERE orig_net = $1 AND orig_token_addr = $2 AND ($3::INTEGER IS NULL OR network_id = $3) ORDER BY network_id LIMIT 1"
+
+	var token etherman.TokenWrapped
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getWrappedTokenSQL, originalNetwork, originalTokenAddress, networkID).Scan(&token.NetworkID, &token.OriginalNetwork, &token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, gerror.ErrStorageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &token, p.updateTokenWrappedMetadata(ctx, &token, dbTx)
+}
+
+// GetTokenWrappedByAddress gets the wrapped token with the address in the network.
+func (p *PostgresStorage) GetTokenWrappedByAddress(ctx context.Context, wrappedTokenAddress common.Address, networkID uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error) {
+	const getWrappedTokenByAddressSQL = getTokenWrappedSQL + " WHERE wrapped_token_addr = $1 AND network_id = $2"
 
 	var token etherman.TokenWrapped
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getWrappedTokenSQL, originalNetwork, originalTokenAddress).Scan(&token.NetworkID, &token.OriginalNetwork, &token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getWrappedTokenByAddressSQL, wrappedTokenAddress, networkID).Scan(&token.NetworkID, &token.OriginalNetwork, &token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, gerror.ErrStorageNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return &token, p.updateTokenWrappedMetadata(ctx, &token, dbTx)
+}
+
+// GetTokensWrapped gets the wrapped tokens matching the filter, ordered by network and original token.
+func (p *PostgresStorage) GetTokensWrapped(ctx context.Context, filter *etherman.TokenWrappedFilter, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.TokenWrapped, error) {
+	conditions := tokenWrappedConditions(filter)
+	query := getTokenWrappedSQL + conditions.where() + " ORDER BY network_id, orig_net, orig_token_addr LIMIT " + conditions.placeholder(limit) + " OFFSET " + conditions.placeholder(offset)
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, query, conditions.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]*etherman.TokenWrapped, 0, limit)
+	for rows.Next() {
+		var token etherman.TokenWrapped
+		err = rows.Scan(&token.NetworkID, &token.OriginalNetwork, &token.OriginalTokenAddress, &token.WrappedTokenAddress, &token.BlockID, &token.Name, &token.Symbol, &token.Decimals)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
+	rows.Close()
+	for _, token := range tokens {
+		if err = p.updateTokenWrappedMetadata(ctx, token, dbTx); err != nil {
+			return nil, err
+		}
+	}
+	return tokens, nil
+}
 
+// GetTokenWrappedCount gets the number of wrapped tokens matching the filter.
+func (p *PostgresStorage) GetTokenWrappedCount(ctx context.Context, filter *etherman.TokenWrappedFilter, dbTx pgx.Tx) (uint64, error) {
+	const getTokenWrappedCountSQL = "SELECT COUNT(*) FROM syncv2.token_wrapped"
+	conditions := tokenWrappedConditions(filter)
+	var tokenCount uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getTokenWrappedCountSQL+conditions.where(), conditions.args...).Scan(&tokenCount)
+	return tokenCount, err
+}
+
+// updateTokenWrappedMetadata stores the metadata of the wrapped token if it wasn't known when the token was synced.
+func (p *PostgresStorage) updateTokenWrappedMetadata(ctx context.Context, token *etherman.TokenWrapped, dbTx pgx.Tx) error {
 	// this is due to missing the related deposit in the opposite network in fast sync mode.
 	// ref: https://github.com/0xPolygonHermez/zkevm-bridge-service/issues/230
-	if token.Symbol == "" {
-		metadata, err := p.GetTokenMetadata(ctx, token.OriginalNetwork, token.NetworkID, token.OriginalTokenAddress, dbTx)
-		var tokenMetadata *etherman.TokenMetadata
-		if err != nil {
-			if err != pgx.ErrNoRows {
-				return nil, err
-			}
-		} else {
-			tokenMetadata, err = getDecodedToken(metadata)
-			if err != nil {
-				return nil, err
-			}
-			updateWrappedTokenSQL := "UPDATE syncv2.token_wrapped SET name = $3, symbol = $4, decimals = $5  WHERE orig_net = $1 AND orig_token_addr = $2" //nolint: gosec
-			_, err = p.getExecQuerier(dbTx).Exec(ctx, updateWrappedTokenSQL, originalNetwork, originalTokenAddress, tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals)
-			if err != nil {
-				return nil, err
-			}
-			token.Name, token.Symbol, token.Decimals = tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals
+	if token.Symbol != "" {
+		return nil
+	}
+	metadata, err := p.GetTokenMetadata(ctx, token.OriginalNetwork, token.NetworkID, token.OriginalTokenAddress, dbTx)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			return err
 		}
+		return nil
 	}
-	return &token, err
+	tokenMetadata, err := getDecodedToken(metadata)
+	if err != nil {
+		return err
+	}
+	updateWrappedTokenSQL := "UPDATE syncv2.token_wrapped SET name = $4, symbol = $5, decimals = $6  WHERE orig_net = $1 AND orig_token_addr = $2 AND network_id = $3" //nolint: gosec
+	_, err = p.getExecQuerier(dbTx).Exec(ctx, updateWrappedTokenSQL, token.OriginalNetwork, token.OriginalTokenAddress, token.NetworkID, tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals)
+	if err != nil {
+		return err
+	}
+	token.Name, token.Symbol, token.Decimals = tokenMetadata.Name, tokenMetadata.Symbol, tokenMetadata.Decimals
+	return nil
 }
 
 // GetDepositCountByRoot gets the deposit count by the root.
@@ -428,6 +636,19 @@ func (p *PostgresStorage) SetRoot(ctx context.Context, root []byte, depositCnt u
 	return err
 }
 
+// BulkSetRoot stores multiple roots with their deposit counts to the storage.
+func (p *PostgresStorage) BulkSetRoot(ctx context.Context, roots [][]byte, depositCnts []uint, network uint8, dbTx pgx.Tx) error {
+	if len(roots) != len(depositCnts) {
+		return fmt.Errorf("mismatched number of roots and deposit counts: %d, %d", len(roots), len(depositCnts))
+	}
+	rows := make([][]interface{}, 0, len(roots))
+	for i := range roots {
+		rows = append(rows, []interface{}{roots[i], depositCnts[i], network})
+	}
+	_, err := p.getExecQuerier(dbTx).CopyFrom(ctx, pgx.Identifier{"mtv2", "root"}, []string{"root", "deposit_cnt", "network"}, pgx.CopyFromRows(rows))
+	return err
+}
+
 // Get gets value of key from the merkle tree.
 func (p *PostgresStorage) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][]byte, error) {
 	const getValueByKeySQL = "SELECT value FROM mtv2.rht WHERE key = $1"
@@ -441,16 +662,133 @@ func (p *PostgresStorage) Get(ctx context.Context, key []byte, dbTx pgx.Tx) ([][
 
 // Set inserts a key-value pair into the db.
 // If record with such a key already exists its assumed that the value is correct,
-// because it's a reverse hash table, and the key is a hash of the value
+// because it's a reverse hash table, and the key is a hash of the value.
+// The existing record is rewritten, so the garbage collector sees it was written again and doesn't remove it.
+// As in BulkSet, the shared merkle tree lock is held until the transaction ends.
 func (p *PostgresStorage) Set(ctx context.Context, key []byte, value [][]byte, dbTx pgx.Tx) error {
-	const setNodeSQL = "INSERT INTO mtv2.rht (key, value) VALUES ($1, $2)"
-	_, err := p.getExecQuerier(dbTx).Exec(ctx, setNodeSQL, key, pq.Array(value))
-	if err != nil && strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
-		return nil
-	}
+	const setNodeSQL = `
+		WITH mt_lock AS (SELECT pg_advisory_xact_lock_shared($3))
+		INSERT INTO mtv2.rht (key, value) SELECT $1, $2 FROM mt_lock
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, setNodeSQL, key, pq.Array(value), mtLockID)
 	return err
 }
 
+// BulkSet inserts multiple key-value pairs into the db in a single batch.
+// As in Set, the keys which already exist are rewritten. An existing node may be unreachable at this point,
+// so the shared merkle tree lock is held until the transaction ends to keep the garbage collector from removing it.
+func (p *PostgresStorage) BulkSet(ctx context.Context, keys [][]byte, values [][][]byte, dbTx pgx.Tx) error {
+	const setNodeSQL = "INSERT INTO mtv2.rht (key, value) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value"
+	if len(keys) != len(values) {
+		return fmt.Errorf("mismatched number of keys and values: %d, %d", len(keys), len(values))
+	}
+	batch := &pgx.Batch{}
+	batch.Queue("SELECT pg_advisory_xact_lock_shared($1)", mtLockID)
+	for i := range keys {
+		batch.Queue(setNodeSQL, keys[i], pq.Array(values[i]))
+	}
+	return p.getExecQuerier(dbTx).SendBatch(ctx, batch).Close()
+}
+
+// GetUnreachableNodes gets the merkle tree nodes which are not reachable from any stored root of any network, with
+// the versions of their rows. It doesn't take any lock, so the nodes may be written again meanwhile, which changes
+// their versions.
+func (p *PostgresStorage) GetUnreachableNodes(ctx context.Context, dbTx pgx.Tx) ([][]byte, []uint64, error) {
+	const getUnreachableNodesSQL = `
+		WITH RECURSIVE reachable (key) AS (
+			SELECT root FROM mtv2.root
+			UNION
+			SELECT UNNEST(rht.value) FROM mtv2.rht INNER JOIN reachable ON rht.key = reachable.key
+		)
+		SELECT key, xmin::text::bigint FROM mtv2.rht WHERE NOT EXISTS (SELECT 1 FROM reachable WHERE reachable.key = rht.key)`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getUnreachableNodesSQL)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var (
+		keys     [][]byte
+		versions []uint64
+	)
+	for rows.Next() {
+		var (
+			key     []byte
+			version uint64
+		)
+		err = rows.Scan(&key, &version)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+		versions = append(versions, version)
+	}
+	return keys, versions, rows.Err()
+}
+
+// DeleteNodes removes the merkle tree nodes returned by GetUnreachableNodes which were not written again since then.
+// It takes the exclusive merkle tree lock, so it waits for the running transactions writing nodes and blocks the new
+// ones until dbTx ends. The number of nodes should be bounded to keep the lock short.
+func (p *PostgresStorage) DeleteNodes(ctx context.Context, keys [][]byte, versions []uint64, dbTx pgx.Tx) (uint64, error) {
+	const deleteNodesSQL = `
+		DELETE FROM mtv2.rht USING UNNEST($1::bytea[], $2::bigint[]) AS node (key, version)
+		WHERE rht.key = node.key AND rht.xmin::text::bigint = node.version`
+	if len(keys) != len(versions) {
+		return 0, fmt.Errorf("mismatched number of keys and versions: %d, %d", len(keys), len(versions))
+	}
+	// The lock is released when the transaction ends, so the nodes are removed in their own transaction
+	if dbTx == nil {
+		tx, err := p.BeginDBTransaction(ctx)
+		if err != nil {
+			return 0, err
+		}
+		defer tx.Rollback(ctx) //nolint:errcheck
+		count, err := p.DeleteNodes(ctx, keys, versions, tx)
+		if err != nil {
+			return 0, err
+		}
+		return count, tx.Commit(ctx)
+	}
+	e := p.getExecQuerier(dbTx)
+	_, err := e.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", mtLockID)
+	if err != nil {
+		return 0, err
+	}
+	commandTag, err := e.Exec(ctx, deleteNodesSQL, keys, versions)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(commandTag.RowsAffected()), nil
+}
+
+// GetRoots gets the roots of the merkle tree stored for the deposit counts in (fromDepositCnt, toDepositCnt], ordered by the deposit count.
+func (p *PostgresStorage) GetRoots(ctx context.Context, network uint8, fromDepositCnt uint, toDepositCnt uint, dbTx pgx.Tx) ([]uint, [][]byte, error) {
+	const getRootsSQL = "SELECT deposit_cnt, root FROM mtv2.root WHERE network = $1 AND deposit_cnt > $2 AND deposit_cnt <= $3 ORDER BY deposit_cnt"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getRootsSQL, network, fromDepositCnt, toDepositCnt)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var (
+		depositCnts []uint
+		roots       [][]byte
+	)
+	for rows.Next() {
+		var (
+			depositCnt uint
+			root       []byte
+		)
+		err = rows.Scan(&depositCnt, &root)
+		if err != nil {
+			return nil, nil, err
+		}
+		depositCnts = append(depositCnts, depositCnt)
+		roots = append(roots, root)
+	}
+	return depositCnts, roots, rows.Err()
+}
+
 // GetLastDepositCount gets the last deposit count from the merkle tree.
 func (p *PostgresStorage) GetLastDepositCount(ctx context.Context, network uint8, dbTx pgx.Tx) (uint, error) {
 	var depositCnt int64
@@ -483,40 +821,182 @@ func (p *PostgresStorage) GetClaimCount(ctx context.Context, destAddr string, db
 	return claimCount, err
 }
 
-// GetClaims gets the claim list which be smaller than index.
-func (p *PostgresStorage) GetClaims(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
-	const getClaimsSQL = "SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, network_id, tx_hash FROM syncv2.claim WHERE dest_addr = $1 ORDER BY block_id DESC LIMIT $2 OFFSET $3"
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, getClaimsSQL, common.FromHex(destAddr), limit, offset)
+// GetClaims gets the claims of the destination address, from the newest to the oldest. If after is provided, the
+// claims start after it.
+func (p *PostgresStorage) GetClaims(ctx context.Context, destAddr string, after *etherman.Cursor, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Claim, error) {
+	const getClaimsSQL = "SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, network_id, tx_hash, source_net FROM syncv2.claim"
+	conditions := &sqlConditions{}
+	conditions.add("dest_addr = %s", common.FromHex(destAddr))
+	if after != nil {
+		conditions.add("(block_id, network_id, index) < (%s, %s, %s)", after.BlockID, after.NetworkID, after.Index)
+	}
+	query := getClaimsSQL + conditions.where() + " ORDER BY block_id DESC, network_id DESC, index DESC LIMIT " + conditions.placeholder(limit) + " OFFSET " + conditions.placeholder(offset)
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, query, conditions.args...)
 	if err != nil {
 		return nil, err
 	}
-	claims := make([]*etherman.Claim, 0, len(rows.RawValues()))
+	defer rows.Close()
+	claims := make([]*etherman.Claim, 0, limit)
 
 	for rows.Next() {
 		var (
 			claim  etherman.Claim
 			amount string
 		)
-		err = rows.Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.NetworkID, &claim.TxHash)
+		err = rows.Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.NetworkID, &claim.TxHash, &claim.SourceNetwork)
 		if err != nil {
 			return nil, err
 		}
 		claim.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
 		claims = append(claims, &claim)
 	}
+	return claims, rows.Err()
+}
+
+// GetDepositsByTxHash gets the deposits of a transaction, ordered by the network and the deposit count.
+func (p *PostgresStorage) GetDepositsByTxHash(ctx context.Context, txHash common.Hash, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getDepositsByTxHashSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, sender, b.received_at, d.state FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE d.tx_hash = $1 ORDER BY d.network_id, d.deposit_cnt"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getDepositsByTxHashSQL, txHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deposits []*etherman.Deposit
+	for rows.Next() {
+		var (
+			deposit etherman.Deposit
+			amount  string
+			sender  []byte
+		)
+		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &sender, &deposit.ReceivedAt, &deposit.State)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		deposit.Sender = common.BytesToAddress(sender)
+		deposits = append(deposits, &deposit)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(deposits) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return deposits, nil
+}
+
+// GetClaimsByTxHash gets the claims of a transaction, ordered by the network, the source network and the index.
+func (p *PostgresStorage) GetClaimsByTxHash(ctx context.Context, txHash common.Hash, dbTx pgx.Tx) ([]*etherman.Claim, error) {
+	const getClaimsByTxHashSQL = "SELECT index, orig_net, orig_addr, amount, dest_addr, block_id, b.block_num, c.network_id, tx_hash, source_net FROM syncv2.claim as c INNER JOIN syncv2.block as b ON c.network_id = b.network_id AND c.block_id = b.id WHERE c.tx_hash = $1 ORDER BY c.network_id, c.source_net, c.index"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getClaimsByTxHashSQL, txHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claims []*etherman.Claim
+	for rows.Next() {
+		var (
+			claim  etherman.Claim
+			amount string
+		)
+		err = rows.Scan(&claim.Index, &claim.OriginalNetwork, &claim.OriginalAddress, &amount, &claim.DestinationAddress, &claim.BlockID, &claim.BlockNumber, &claim.NetworkID, &claim.TxHash, &claim.SourceNetwork)
+		if err != nil {
+			return nil, err
+		}
+		claim.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		claims = append(claims, &claim)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(claims) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
 	return claims, nil
 }
 
-// GetDeposits gets the deposit list which be smaller than depositCount.
-func (p *PostgresStorage) GetDeposits(ctx context.Context, destAddr string, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
-	const getDepositsSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE dest_addr = $1 ORDER BY d.block_id DESC LIMIT $2 OFFSET $3"
-	rows, err := p.getExecQuerier(dbTx).Query(ctx, getDepositsSQL, common.FromHex(destAddr), limit, offset)
+// GetDeposits gets the deposits matching the filter, from the newest to the oldest. If after is provided, the
+// deposits start after it.
+func (p *PostgresStorage) GetDeposits(ctx context.Context, filter *etherman.DepositFilter, after *etherman.Cursor, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getDepositsSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, sender, b.received_at, d.state FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id"
+	conditions := depositConditions(filter)
+	if after != nil {
+		conditions.add("(d.block_id, d.network_id, d.deposit_cnt) < (%s, %s, %s)", after.BlockID, after.NetworkID, after.Index)
+	}
+	query := getDepositsSQL + conditions.where() + " ORDER BY d.block_id DESC, d.network_id DESC, d.deposit_cnt DESC LIMIT " + conditions.placeholder(limit) + " OFFSET " + conditions.placeholder(offset)
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, query, conditions.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deposits := make([]*etherman.Deposit, 0, limit)
+
+	for rows.Next() {
+		var (
+			deposit etherman.Deposit
+			amount  string
+			sender  []byte
+		)
+		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &sender, &deposit.ReceivedAt, &deposit.State)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		deposit.Sender = common.BytesToAddress(sender)
+		deposits = append(deposits, &deposit)
+	}
+
+	return deposits, rows.Err()
+}
+
+// GetNetworkDepositCount gets the number of deposits of the network, the next deposit count.
+func (p *PostgresStorage) GetNetworkDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error) {
+	const getNetworkDepositCountSQL = "SELECT COALESCE(MAX(deposit_cnt) + 1, 0) FROM syncv2.deposit WHERE network_id = $1"
+	var depositCnt uint
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getNetworkDepositCountSQL, networkID).Scan(&depositCnt)
+	return depositCnt, err
+}
+
+// GetNetworkDeposits gets the deposits of the network from a deposit count, ordered by the deposit count.
+func (p *PostgresStorage) GetNetworkDeposits(ctx context.Context, networkID uint, fromDepositCnt uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getNetworkDepositsSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata, sender, b.received_at, d.state FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE d.network_id = $1 AND deposit_cnt >= $2 ORDER BY deposit_cnt LIMIT $3"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getNetworkDepositsSQL, networkID, fromDepositCnt, limit)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	deposits := make([]*etherman.Deposit, 0, len(rows.RawValues()))
+	deposits := make([]*etherman.Deposit, 0, limit)
+	for rows.Next() {
+		var (
+			deposit etherman.Deposit
+			amount  string
+			sender  []byte
+		)
+		err = rows.Scan(&deposit.LeafType, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork, &deposit.DestinationAddress, &deposit.DepositCount, &deposit.BlockID, &deposit.BlockNumber, &deposit.NetworkID, &deposit.TxHash, &deposit.Metadata, &sender, &deposit.ReceivedAt, &deposit.State)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		deposit.Sender = common.BytesToAddress(sender)
+		deposits = append(deposits, &deposit)
+	}
+	return deposits, rows.Err()
+}
+
+// GetDepositsWithoutSender gets the deposits of the network whose sender isn't stored, ordered by the deposit count.
+func (p *PostgresStorage) GetDepositsWithoutSender(ctx context.Context, networkID uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const getDepositsWithoutSenderSQL = "SELECT leaf_type, orig_net, orig_addr, amount, dest_net, dest_addr, deposit_cnt, block_id, b.block_num, d.network_id, tx_hash, metadata FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id WHERE d.network_id = $1 AND sender IS NULL ORDER BY deposit_cnt LIMIT $2"
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getDepositsWithoutSenderSQL, networkID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
+	deposits := make([]*etherman.Deposit, 0, limit)
 	for rows.Next() {
 		var (
 			deposit etherman.Deposit
@@ -529,15 +1009,28 @@ func (p *PostgresStorage) GetDeposits(ctx context.Context, destAddr string, limi
 		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
 		deposits = append(deposits, &deposit)
 	}
+	return deposits, rows.Err()
+}
 
-	return deposits, nil
+// UpdateDepositSender stores the sender of a deposit.
+func (p *PostgresStorage) UpdateDepositSender(ctx context.Context, networkID uint, depositCnt uint, sender common.Address, dbTx pgx.Tx) error {
+	const updateDepositSenderSQL = "UPDATE syncv2.deposit SET sender = $3 WHERE network_id = $1 AND deposit_cnt = $2"
+	tag, err := p.getExecQuerier(dbTx).Exec(ctx, updateDepositSenderSQL, networkID, depositCnt, sender)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return gerror.ErrStorageNotFound
+	}
+	return nil
 }
 
-// GetDepositCount gets the deposit count for the destination address.
-func (p *PostgresStorage) GetDepositCount(ctx context.Context, destAddr string, dbTx pgx.Tx) (uint64, error) {
-	const getDepositCountSQL = "SELECT COUNT(*) FROM syncv2.deposit WHERE dest_addr = $1"
+// GetDepositCount gets the number of deposits matching the filter.
+func (p *PostgresStorage) GetDepositCount(ctx context.Context, filter *etherman.DepositFilter, dbTx pgx.Tx) (uint64, error) {
+	const getDepositCountSQL = "SELECT COUNT(*) FROM syncv2.deposit as d INNER JOIN syncv2.block as b ON d.network_id = b.network_id AND d.block_id = b.id"
+	conditions := depositConditions(filter)
 	var depositCount uint64
-	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositCountSQL, common.FromHex(destAddr)).Scan(&depositCount)
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getDepositCountSQL+conditions.where(), conditions.args...).Scan(&depositCount)
 	return depositCount, err
 }
 
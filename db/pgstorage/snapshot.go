@@ -0,0 +1,315 @@
+package pgstorage
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// SnapshotVersion is the version of the snapshot archive format
+	SnapshotVersion = 1
+
+	snapshotManifestFile = "manifest.json"
+	snapshotTableSuffix  = ".copy"
+)
+
+// snapshotTables are the tables of a snapshot, in the order they are restored to satisfy the foreign keys.
+var snapshotTables = []string{
+	"syncv2.block",
+	"syncv2.exit_root",
+	"syncv2.batch",
+	"syncv2.verified_batch",
+	"syncv2.forced_batch",
+	"syncv2.deposit",
+	"syncv2.claim",
+	"syncv2.deposit_transition",
+	"syncv2.deposit_stats",
+	"syncv2.claim_stats",
+	"syncv2.token_wrapped",
+	"syncv2.network",
+	"mtv2.rht",
+	"mtv2.root",
+	"mtv2.rollup_exit",
+}
+
+// snapshotSequences are the sequences of the serial columns, they are moved past the restored ids.
+var snapshotSequences = map[string]string{
+	"syncv2.block_id_seq":              "syncv2.block",
+	"syncv2.exit_root_id_seq":          "syncv2.exit_root",
+	"syncv2.deposit_transition_id_seq": "syncv2.deposit_transition",
+	"mtv2.rollup_exit_id_seq":          "mtv2.rollup_exit",
+}
+
+// SnapshotManifest describes the content of a snapshot archive.
+type SnapshotManifest struct {
+	Version int `json:"version"`
+	// SchemaVersion is the last migration applied to the exported database
+	SchemaVersion string    `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	// LastBlocks is the last synced block number of each network
+	LastBlocks map[uint]uint64 `json:"last_blocks"`
+	Tables     []SnapshotTable `json:"tables"`
+}
+
+// SnapshotTable is a table of a snapshot archive, the checksum is the sha256 of its COPY data.
+type SnapshotTable struct {
+	Name     string `json:"name"`
+	Rows     int64  `json:"rows"`
+	Checksum string `json:"checksum"`
+}
+
+// ExportSnapshot writes the sync and merkle tree tables to a gzipped tar archive. All the tables are read inside
+// a repeatable read transaction, so the snapshot is consistent at the last synced blocks. The data of each table
+// is written in the COPY text format, and the manifest with the checksums is the last file of the archive.
+func (p *PostgresStorage) ExportSnapshot(ctx context.Context, w io.Writer) (*SnapshotManifest, error) {
+	dbTx, err := p.BeginSnapshotDBTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer dbTx.Rollback(ctx) //nolint:errcheck
+
+	manifest := &SnapshotManifest{
+		Version:    SnapshotVersion,
+		CreatedAt:  time.Now().UTC(),
+		LastBlocks: make(map[uint]uint64),
+	}
+	manifest.SchemaVersion, err = schemaVersion(ctx, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	const getLastBlocksSQL = "SELECT network_id, MAX(block_num) FROM syncv2.block WHERE network_id IS NOT NULL GROUP BY network_id"
+	rows, err := dbTx.Query(ctx, getLastBlocksSQL)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var (
+			networkID   uint
+			blockNumber uint64
+		)
+		if err = rows.Scan(&networkID, &blockNumber); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		manifest.LastBlocks[networkID] = blockNumber
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, table := range snapshotTables {
+		snapshotTable, err := exportTable(ctx, dbTx, table, tw)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting table %s: %w", table, err)
+		}
+		manifest.Tables = append(manifest.Tables, *snapshotTable)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	err = tw.WriteHeader(&tar.Header{Name: snapshotManifestFile, Mode: 0600, Size: int64(len(data)), ModTime: manifest.CreatedAt}) //nolint:gomnd
+	if err != nil {
+		return nil, err
+	}
+	if _, err = tw.Write(data); err != nil {
+		return nil, err
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, gz.Close()
+}
+
+// exportTable copies the table to a temporary file, the size of the tar entry must be known before writing it.
+func exportTable(ctx context.Context, dbTx pgx.Tx, table string, tw *tar.Writer) (*SnapshotTable, error) {
+	f, err := os.CreateTemp("", "snapshot-*"+snapshotTableSuffix)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name()) //nolint:errcheck
+	defer f.Close()           //nolint:errcheck
+
+	hash := sha256.New()
+	tag, err := dbTx.Conn().PgConn().CopyTo(ctx, io.MultiWriter(f, hash), "COPY "+table+" TO STDOUT")
+	if err != nil {
+		return nil, err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	err = tw.WriteHeader(&tar.Header{Name: table + snapshotTableSuffix, Mode: 0600, Size: size, ModTime: time.Now()}) //nolint:gomnd
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(tw, f); err != nil {
+		return nil, err
+	}
+	return &SnapshotTable{
+		Name:     table,
+		Rows:     tag.RowsAffected(),
+		Checksum: hex.EncodeToString(hash.Sum(nil)),
+	}, nil
+}
+
+// ImportSnapshot restores a snapshot archive written by ExportSnapshot into an empty database with the same schema
+// version. The tables are restored inside a single transaction, which is only committed if the checksums and the
+// row counts of the manifest match the restored data, and verify, if not nil, returns no error for the restored data.
+func (p *PostgresStorage) ImportSnapshot(ctx context.Context, r io.Reader, verify func(dbTx pgx.Tx) error) (*SnapshotManifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close() //nolint:errcheck
+
+	dbTx, err := p.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer dbTx.Rollback(ctx) //nolint:errcheck
+
+	if err = checkEmptyDatabase(ctx, dbTx); err != nil {
+		return nil, err
+	}
+	// The block with id 0 inserted by the migrations is part of the snapshot
+	if _, err = dbTx.Exec(ctx, "DELETE FROM syncv2.block"); err != nil {
+		return nil, err
+	}
+
+	var (
+		manifest *SnapshotManifest
+		restored = make(map[string]SnapshotTable)
+		tr       = tar.NewReader(gz)
+		next     int
+	)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Name == snapshotManifestFile {
+			manifest = &SnapshotManifest{}
+			if err = json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("error decoding the snapshot manifest: %w", err)
+			}
+			continue
+		}
+		if next >= len(snapshotTables) || header.Name != snapshotTables[next]+snapshotTableSuffix {
+			return nil, fmt.Errorf("unexpected file %s in the snapshot", header.Name)
+		}
+		table := snapshotTables[next]
+		next++
+		hash := sha256.New()
+		tag, err := dbTx.Conn().PgConn().CopyFrom(ctx, io.TeeReader(tr, hash), "COPY "+table+" FROM STDIN")
+		if err != nil {
+			return nil, fmt.Errorf("error importing table %s: %w", table, err)
+		}
+		restored[table] = SnapshotTable{Name: table, Rows: tag.RowsAffected(), Checksum: hex.EncodeToString(hash.Sum(nil))}
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("the snapshot has no manifest")
+	}
+	if manifest.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d, expected %d", manifest.Version, SnapshotVersion)
+	}
+	schema, err := schemaVersion(ctx, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.SchemaVersion != schema {
+		return nil, fmt.Errorf("the snapshot schema version %s doesn't match the database schema version %s", manifest.SchemaVersion, schema)
+	}
+	if len(manifest.Tables) != len(restored) {
+		return nil, fmt.Errorf("the snapshot has %d tables, the manifest has %d", len(restored), len(manifest.Tables))
+	}
+	for _, table := range manifest.Tables {
+		if restored[table.Name] != table {
+			return nil, fmt.Errorf("the table %s doesn't match the manifest. Restored: %+v, manifest: %+v", table.Name, restored[table.Name], table)
+		}
+	}
+
+	for sequence, table := range snapshotSequences {
+		_, err = dbTx.Exec(ctx, fmt.Sprintf("SELECT setval('%s', COALESCE(MAX(id), 0) + 1, false) FROM %s", sequence, table))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if verify != nil {
+		if err = verify(dbTx); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, dbTx.Commit(ctx)
+}
+
+// DeleteSnapshot removes the rows restored by ImportSnapshot, so the database is left as the migrations created it.
+func (p *PostgresStorage) DeleteSnapshot(ctx context.Context) error {
+	dbTx, err := p.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer dbTx.Rollback(ctx) //nolint:errcheck
+
+	for i := len(snapshotTables) - 1; i >= 0; i-- {
+		if _, err = dbTx.Exec(ctx, "DELETE FROM "+snapshotTables[i]); err != nil {
+			return err
+		}
+	}
+	_, err = dbTx.Exec(ctx, `INSERT INTO syncv2.block (id, block_hash, received_at) VALUES (0, '\\x0', to_timestamp(0))`)
+	if err != nil {
+		return err
+	}
+	for sequence := range snapshotSequences {
+		if _, err = dbTx.Exec(ctx, fmt.Sprintf("SELECT setval('%s', 1, false)", sequence)); err != nil {
+			return err
+		}
+	}
+	return dbTx.Commit(ctx)
+}
+
+// schemaVersion returns the last migration applied to the database.
+func schemaVersion(ctx context.Context, dbTx pgx.Tx) (string, error) {
+	var version string
+	err := dbTx.QueryRow(ctx, "SELECT id FROM gorp_migrations ORDER BY id DESC LIMIT 1").Scan(&version)
+	return version, err
+}
+
+// checkEmptyDatabase checks that the database only has the rows inserted by the migrations.
+func checkEmptyDatabase(ctx context.Context, dbTx pgx.Tx) error {
+	for _, table := range snapshotTables {
+		query := "SELECT EXISTS (SELECT 1 FROM " + table + ")"
+		if table == "syncv2.block" {
+			query = "SELECT EXISTS (SELECT 1 FROM syncv2.block WHERE id <> 0)"
+		}
+		var found bool
+		if err := dbTx.QueryRow(ctx, query).Scan(&found); err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("the database isn't empty, the table %s has rows", table)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package pgstorage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// The deposits and the claims of the blocks matching the condition are added to the hourly rollups, multiplied
+	// by the sign in $1, so the rollups are reverted with a negative sign.
+	updateDepositStatsSQL = `INSERT INTO syncv2.deposit_stats (network_id, dest_net, orig_net, orig_addr, hour, deposit_cnt, amount)
+		SELECT d.network_id, d.dest_net, d.orig_net, d.orig_addr, date_trunc('hour', b.received_at), $1::INTEGER * COUNT(*), $1::INTEGER * SUM(d.amount::NUMERIC)
+		FROM syncv2.deposit AS d INNER JOIN syncv2.block AS b ON d.block_id = b.id WHERE %s
+		GROUP BY d.network_id, d.dest_net, d.orig_net, d.orig_addr, date_trunc('hour', b.received_at)
+		ON CONFLICT (network_id, dest_net, orig_net, orig_addr, hour) DO UPDATE
+		SET deposit_cnt = syncv2.deposit_stats.deposit_cnt + EXCLUDED.deposit_cnt, amount = syncv2.deposit_stats.amount + EXCLUDED.amount`
+	updateClaimStatsSQL = `INSERT INTO syncv2.claim_stats (network_id, source_net, orig_net, orig_addr, hour, claim_cnt, amount)
+		SELECT c.network_id, c.source_net, c.orig_net, c.orig_addr, date_trunc('hour', b.received_at), $1::INTEGER * COUNT(*), $1::INTEGER * SUM(c.amount::NUMERIC)
+		FROM syncv2.claim AS c INNER JOIN syncv2.block AS b ON c.block_id = b.id WHERE %s
+		GROUP BY c.network_id, c.source_net, c.orig_net, c.orig_addr, date_trunc('hour', b.received_at)
+		ON CONFLICT (network_id, source_net, orig_net, orig_addr, hour) DO UPDATE
+		SET claim_cnt = syncv2.claim_stats.claim_cnt + EXCLUDED.claim_cnt, amount = syncv2.claim_stats.amount + EXCLUDED.amount`
+)
+
+// AddBlockStats adds the deposits and the claims of the block to the statistics.
+func (p *PostgresStorage) AddBlockStats(ctx context.Context, blockID uint64, dbTx pgx.Tx) error {
+	return p.updateStats(ctx, 1, "b.id = $2", []interface{}{blockID}, dbTx)
+}
+
+// removeResetStats removes from the statistics the deposits and the claims of the blocks of the network after the
+// block number, before they are removed by a reset.
+func (p *PostgresStorage) removeResetStats(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) error {
+	const (
+		removeEmptyDepositStatsSQL = "DELETE FROM syncv2.deposit_stats WHERE deposit_cnt = 0"
+		removeEmptyClaimStatsSQL   = "DELETE FROM syncv2.claim_stats WHERE claim_cnt = 0"
+	)
+	err := p.updateStats(ctx, -1, "b.block_num > $2 AND b.network_id = $3", []interface{}{blockNumber, networkID}, dbTx)
+	if err != nil {
+		return err
+	}
+	e := p.getExecQuerier(dbTx)
+	if _, err = e.Exec(ctx, removeEmptyDepositStatsSQL); err != nil {
+		return err
+	}
+	_, err = e.Exec(ctx, removeEmptyClaimStatsSQL)
+	return err
+}
+
+func (p *PostgresStorage) updateStats(ctx context.Context, sign int, blockCondition string, args []interface{}, dbTx pgx.Tx) error {
+	e := p.getExecQuerier(dbTx)
+	args = append([]interface{}{sign}, args...)
+	if _, err := e.Exec(ctx, fmt.Sprintf(updateDepositStatsSQL, blockCondition), args...); err != nil {
+		return err
+	}
+	_, err := e.Exec(ctx, fmt.Sprintf(updateClaimStatsSQL, blockCondition), args...)
+	return err
+}
+
+type tokenStatsKey struct {
+	networkID       uint
+	originalNetwork uint
+	originalAddress common.Address
+}
+
+// GetTokenStats gets the statistics of every token with deposits or claims in every network, ordered by network and
+// token. The volume of each window is the one of the hours since the time, including the hour of the time.
+func (p *PostgresStorage) GetTokenStats(ctx context.Context, since []time.Time, dbTx pgx.Tx) ([]*etherman.TokenStats, error) {
+	const (
+		getVolumesSQL = `SELECT network_id, orig_net, orig_addr, SUM(deposit_cnt)::BIGINT, SUM(deposit_amount)::TEXT, SUM(claim_cnt)::BIGINT, SUM(claim_amount)::TEXT
+			FROM (
+				SELECT network_id, orig_net, orig_addr, deposit_cnt, amount AS deposit_amount, 0 AS claim_cnt, 0 AS claim_amount
+				FROM syncv2.deposit_stats WHERE hour >= date_trunc('hour', $1::TIMESTAMP WITH TIME ZONE)
+				UNION ALL
+				SELECT network_id, orig_net, orig_addr, 0, 0, claim_cnt, amount
+				FROM syncv2.claim_stats WHERE hour >= date_trunc('hour', $1::TIMESTAMP WITH TIME ZONE)
+			) AS v GROUP BY network_id, orig_net, orig_addr`
+		// The deposits of a network are claimed in their destination networks
+		getOutstandingSQL = `SELECT network_id, orig_net, orig_addr, SUM(amount)::TEXT FROM (
+				SELECT network_id, orig_net, orig_addr, amount FROM syncv2.deposit_stats
+				UNION ALL
+				SELECT source_net, orig_net, orig_addr, -amount FROM syncv2.claim_stats
+			) AS o GROUP BY network_id, orig_net, orig_addr`
+	)
+	stats := make(map[tokenStatsKey]*etherman.TokenStats)
+	get := func(key tokenStatsKey) *etherman.TokenStats {
+		tokenStats, found := stats[key]
+		if !found {
+			tokenStats = &etherman.TokenStats{
+				NetworkID:         key.networkID,
+				OriginalNetwork:   key.originalNetwork,
+				OriginalAddress:   key.originalAddress,
+				Total:             newTokenVolume(),
+				Windows:           make([]etherman.TokenVolume, len(since)),
+				OutstandingAmount: big.NewInt(0),
+				LockedAmount:      big.NewInt(0),
+			}
+			for i := range tokenStats.Windows {
+				tokenStats.Windows[i] = newTokenVolume()
+			}
+			stats[key] = tokenStats
+		}
+		return tokenStats
+	}
+
+	e := p.getExecQuerier(dbTx)
+	// The total volume is the one since the first block
+	for i, from := range append([]time.Time{{}}, since...) {
+		rows, err := e.Query(ctx, getVolumesSQL, from)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var (
+				key                        tokenStatsKey
+				volume                     = newTokenVolume()
+				depositAmount, claimAmount string
+			)
+			err = rows.Scan(&key.networkID, &key.originalNetwork, &key.originalAddress, &volume.DepositCount, &depositAmount, &volume.ClaimCount, &claimAmount)
+			if err != nil {
+				rows.Close()
+				return nil, err
+			}
+			volume.DepositAmount.SetString(depositAmount, 10) //nolint:gomnd
+			volume.ClaimAmount.SetString(claimAmount, 10)     //nolint:gomnd
+			if i == 0 {
+				get(key).Total = volume
+			} else {
+				get(key).Windows[i-1] = volume
+			}
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := e.Query(ctx, getOutstandingSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			key    tokenStatsKey
+			amount string
+		)
+		if err = rows.Scan(&key.networkID, &key.originalNetwork, &key.originalAddress, &amount); err != nil {
+			return nil, err
+		}
+		get(key).OutstandingAmount.SetString(amount, 10) //nolint:gomnd
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*etherman.TokenStats, 0, len(stats))
+	for _, tokenStats := range stats {
+		if tokenStats.OriginalNetwork == tokenStats.NetworkID {
+			tokenStats.LockedAmount.Sub(tokenStats.Total.DepositAmount, tokenStats.Total.ClaimAmount)
+		}
+		result = append(result, tokenStats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.NetworkID != b.NetworkID {
+			return a.NetworkID < b.NetworkID
+		}
+		if a.OriginalNetwork != b.OriginalNetwork {
+			return a.OriginalNetwork < b.OriginalNetwork
+		}
+		return bytes.Compare(a.OriginalAddress.Bytes(), b.OriginalAddress.Bytes()) < 0
+	})
+	return result, nil
+}
+
+func newTokenVolume() etherman.TokenVolume {
+	return etherman.TokenVolume{DepositAmount: big.NewInt(0), ClaimAmount: big.NewInt(0)}
+}
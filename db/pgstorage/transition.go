@@ -0,0 +1,178 @@
+package pgstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/jackc/pgx/v4"
+)
+
+// UpdateDepositStates moves the deposits of the network from fromDepositCnt to toDepositCnt, not included, which are
+// in a previous state to the state, and stores their transitions. The blockID is zero for the transitions which
+// aren't synced from a block, observedAt is the time they were observed, or zero if it's unknown. The transitions of
+// the deposits to the addresses with webhook subscriptions are stored as webhook events too.
+func (p *PostgresStorage) UpdateDepositStates(ctx context.Context, networkID uint, fromDepositCnt uint, toDepositCnt uint, state etherman.DepositState, blockID uint64, observedAt time.Time, dbTx pgx.Tx) error {
+	const updateDepositStatesSQL = `WITH updated AS (
+			UPDATE syncv2.deposit SET state = $4 WHERE network_id = $1 AND deposit_cnt >= $2 AND deposit_cnt < $3 AND state < $4
+			RETURNING leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_id, deposit_cnt, tx_hash, metadata, sender
+		), transitions AS (
+			INSERT INTO syncv2.deposit_transition (network_id, deposit_cnt, deposit_block_id, state, block_id, observed_at)
+			SELECT network_id, deposit_cnt, block_id, $4, NULLIF($5::BIGINT, 0), $6::TIMESTAMP WITH TIME ZONE FROM updated
+		)
+		INSERT INTO webhook.event (state, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_num, deposit_cnt, tx_hash, metadata, sender, claim_tx_hash)
+		SELECT $4, u.leaf_type, u.network_id, u.orig_net, u.orig_addr, u.amount, u.dest_net, u.dest_addr, b.block_num, u.deposit_cnt, u.tx_hash, u.metadata, u.sender, c.tx_hash
+		FROM updated AS u INNER JOIN syncv2.block AS b ON b.id = u.block_id
+		LEFT JOIN syncv2.claim AS c ON c.network_id = u.dest_net AND c.index = u.deposit_cnt AND c.source_net = u.network_id
+		WHERE EXISTS (SELECT 1 FROM webhook.subscription AS s WHERE s.address = u.dest_addr)`
+	var observed *time.Time
+	if !observedAt.IsZero() {
+		observed = &observedAt
+	}
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, updateDepositStatesSQL, networkID, fromDepositCnt, toDepositCnt, state, blockID, observed)
+	return err
+}
+
+// GetReadyDepositCount gets the number of deposits of the network which are ready for claim, the claimable and the
+// claimed ones. The deposits are included in the exit roots in the order of their deposit count.
+func (p *PostgresStorage) GetReadyDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error) {
+	const getReadyDepositCountSQL = "SELECT COALESCE(MAX(deposit_cnt) + 1, 0) FROM syncv2.deposit WHERE network_id = $1 AND state >= $2 AND state <= $3"
+	var depositCnt uint
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getReadyDepositCountSQL, networkID, etherman.DepositStateClaimable, etherman.DepositStateClaimed).Scan(&depositCnt)
+	return depositCnt, err
+}
+
+// GetFirstNotReadyDepositCount gets the count of the first deposit of the network which isn't ready for claim, the
+// deposits before it are claimable or claimed. It returns ErrStorageNotFound if all the deposits are ready.
+func (p *PostgresStorage) GetFirstNotReadyDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error) {
+	const getFirstNotReadyDepositCountSQL = "SELECT MIN(deposit_cnt) FROM syncv2.deposit WHERE network_id = $1 AND state < $2"
+	var depositCnt *uint
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, getFirstNotReadyDepositCountSQL, networkID, etherman.DepositStateClaimable).Scan(&depositCnt)
+	if err != nil {
+		return 0, err
+	}
+	if depositCnt == nil {
+		return 0, gerror.ErrStorageNotFound
+	}
+	return *depositCnt, nil
+}
+
+// GetDepositTransitions gets the transitions of the deposits of the network with the deposit count, ordered by the
+// block of the deposit and in the order they were synced. The deposits with the same count removed by a reorg are
+// included, they are told apart by the block of the deposit.
+func (p *PostgresStorage) GetDepositTransitions(ctx context.Context, networkID uint, depositCnt uint, dbTx pgx.Tx) ([]*etherman.DepositTransition, error) {
+	const getDepositTransitionsSQL = `SELECT t.network_id, t.deposit_cnt, t.deposit_block_id, t.state, COALESCE(t.block_id, 0), COALESCE(b.block_num, 0), COALESCE(b.network_id, 0), COALESCE(b.received_at, t.observed_at, t.created_at)
+		FROM syncv2.deposit_transition AS t LEFT JOIN syncv2.block AS b ON t.block_id = b.id
+		WHERE t.network_id = $1 AND t.deposit_cnt = $2 ORDER BY t.deposit_block_id, t.id`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getDepositTransitionsSQL, networkID, depositCnt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []*etherman.DepositTransition
+	for rows.Next() {
+		var (
+			transition etherman.DepositTransition
+			// The time of the transitions backfilled without a block is unknown
+			transitionTime *time.Time
+		)
+		err = rows.Scan(&transition.NetworkID, &transition.DepositCount, &transition.DepositBlockID, &transition.State, &transition.BlockID, &transition.BlockNumber, &transition.BlockNetworkID, &transitionTime)
+		if err != nil {
+			return nil, err
+		}
+		if transitionTime != nil {
+			transition.Time = *transitionTime
+		}
+		transitions = append(transitions, &transition)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(transitions) == 0 {
+		return nil, gerror.ErrStorageNotFound
+	}
+	return transitions, nil
+}
+
+// depositsOfResetTransitions returns the deposits whose transitions were synced in the blocks of the network after
+// the block number, as the arrays of their networks, deposit counts and blocks.
+func (p *PostgresStorage) depositsOfResetTransitions(ctx context.Context, blockNumber uint64, networkID uint, dbTx pgx.Tx) ([]int64, []int64, []int64, error) {
+	const getResetTransitionsSQL = `SELECT DISTINCT t.network_id, t.deposit_cnt, t.deposit_block_id FROM syncv2.deposit_transition AS t
+		INNER JOIN syncv2.block AS b ON t.block_id = b.id WHERE b.block_num > $1 AND b.network_id = $2`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getResetTransitionsSQL, blockNumber, networkID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer rows.Close()
+
+	var networks, depositCnts, blockIDs []int64
+	for rows.Next() {
+		var network, depositCnt, blockID int64
+		if err = rows.Scan(&network, &depositCnt, &blockID); err != nil {
+			return nil, nil, nil, err
+		}
+		networks = append(networks, network)
+		depositCnts = append(depositCnts, depositCnt)
+		blockIDs = append(blockIDs, blockID)
+	}
+	return networks, depositCnts, blockIDs, rows.Err()
+}
+
+// resetDepositStates moves back the deposits to the last state of their remaining transitions.
+func (p *PostgresStorage) resetDepositStates(ctx context.Context, networks []int64, depositCnts []int64, blockIDs []int64, dbTx pgx.Tx) error {
+	const resetDepositStatesSQL = `UPDATE syncv2.deposit AS d SET state = COALESCE((
+			SELECT MAX(t.state) FROM syncv2.deposit_transition AS t
+			WHERE t.network_id = d.network_id AND t.deposit_cnt = d.deposit_cnt AND t.deposit_block_id = d.block_id
+		), 0)
+		FROM unnest($1::INTEGER[], $2::BIGINT[], $3::BIGINT[]) AS r(network_id, deposit_cnt, block_id)
+		WHERE d.network_id = r.network_id AND d.deposit_cnt = r.deposit_cnt AND d.block_id = r.block_id`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, resetDepositStatesSQL, networks, depositCnts, blockIDs)
+	return err
+}
+
+// GetClaimableLatencies gets the distribution of the time the deposits of every network took to be claimable since
+// their block, for the deposits in blocks after the time which are claimable. The networks without claimable
+// deposits are not included. The transitions whose time is unknown aren't sampled: the backfilled ones, the ones
+// without a block which weren't observed, and the ones stored with the deposit because its network was synced after
+// the exit root.
+func (p *PostgresStorage) GetClaimableLatencies(ctx context.Context, since time.Time, dbTx pgx.Tx) ([]*etherman.NetworkLatency, error) {
+	const getClaimableLatenciesSQL = `SELECT network_id, COUNT(*),
+			percentile_cont(0.1) WITHIN GROUP (ORDER BY latency), percentile_cont(0.5) WITHIN GROUP (ORDER BY latency),
+			percentile_cont(0.9) WITHIN GROUP (ORDER BY latency)
+		FROM (
+			SELECT t.network_id, GREATEST(EXTRACT(EPOCH FROM COALESCE(tb.received_at, t.observed_at) - b.received_at)::DOUBLE PRECISION, 0) AS latency
+			FROM syncv2.deposit_transition AS t
+			INNER JOIN syncv2.deposit AS d ON d.network_id = t.network_id AND d.deposit_cnt = t.deposit_cnt AND d.block_id = t.deposit_block_id
+			INNER JOIN syncv2.block AS b ON d.block_id = b.id
+			LEFT JOIN syncv2.block AS tb ON t.block_id = tb.id
+			WHERE t.state = $1 AND t.created_at >= $2 AND b.received_at >= $2
+				AND COALESCE(tb.received_at, t.observed_at) IS NOT NULL AND t.block_id IS DISTINCT FROM t.deposit_block_id
+		) AS l GROUP BY network_id ORDER BY network_id`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getClaimableLatenciesSQL, etherman.DepositStateClaimable, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var latencies []*etherman.NetworkLatency
+	for rows.Next() {
+		var (
+			latency       etherman.NetworkLatency
+			p10, p50, p90 float64
+		)
+		if err = rows.Scan(&latency.NetworkID, &latency.Samples, &p10, &p50, &p90); err != nil {
+			return nil, err
+		}
+		latency.P10 = secondsToDuration(p10)
+		latency.P50 = secondsToDuration(p50)
+		latency.P90 = secondsToDuration(p90)
+		latencies = append(latencies, &latency)
+	}
+	return latencies, rows.Err()
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
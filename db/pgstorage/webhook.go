@@ -0,0 +1,238 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+const getWebhookSubscriptionsSQL = "SELECT id, url, secret, address, network_id, event_types, created_at FROM webhook.subscription"
+
+// AddWebhookSubscription stores a webhook subscription and returns its id.
+func (p *PostgresStorage) AddWebhookSubscription(ctx context.Context, sub *etherman.WebhookSubscription, dbTx pgx.Tx) (uint64, error) {
+	const addWebhookSubscriptionSQL = "INSERT INTO webhook.subscription (url, secret, address, network_id, event_types) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+	eventTypes := make([]int16, 0, len(sub.EventTypes))
+	for _, eventType := range sub.EventTypes {
+		eventTypes = append(eventTypes, int16(eventType))
+	}
+	var id uint64
+	err := p.getExecQuerier(dbTx).QueryRow(ctx, addWebhookSubscriptionSQL, sub.URL, sub.Secret, sub.Address, sub.NetworkID, eventTypes).Scan(&id)
+	return id, err
+}
+
+// DeleteWebhookSubscription removes a webhook subscription with its pending deliveries and dead letters.
+func (p *PostgresStorage) DeleteWebhookSubscription(ctx context.Context, id uint64, dbTx pgx.Tx) error {
+	const deleteWebhookSubscriptionSQL = "DELETE FROM webhook.subscription WHERE id = $1"
+	tag, err := p.getExecQuerier(dbTx).Exec(ctx, deleteWebhookSubscriptionSQL, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return gerror.ErrStorageNotFound
+	}
+	return nil
+}
+
+// GetWebhookSubscriptions gets all the webhook subscriptions.
+func (p *PostgresStorage) GetWebhookSubscriptions(ctx context.Context, dbTx pgx.Tx) ([]*etherman.WebhookSubscription, error) {
+	return p.getWebhookSubscriptions(ctx, getWebhookSubscriptionsSQL+" ORDER BY id", dbTx)
+}
+
+// GetMatchingWebhookSubscriptions gets the webhook subscriptions to the event type of the deposits to the address
+// in the network.
+func (p *PostgresStorage) GetMatchingWebhookSubscriptions(ctx context.Context, address common.Address, networkID uint, eventType uint8, dbTx pgx.Tx) ([]*etherman.WebhookSubscription, error) {
+	const matchingSQL = " WHERE address = $1 AND (network_id IS NULL OR network_id = $2) AND $3 = ANY(event_types) ORDER BY id"
+	return p.getWebhookSubscriptions(ctx, getWebhookSubscriptionsSQL+matchingSQL, dbTx, address, networkID, int16(eventType))
+}
+
+func (p *PostgresStorage) getWebhookSubscriptions(ctx context.Context, sql string, dbTx pgx.Tx, args ...interface{}) ([]*etherman.WebhookSubscription, error) {
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []*etherman.WebhookSubscription
+	for rows.Next() {
+		var (
+			sub        etherman.WebhookSubscription
+			eventTypes []int16
+		)
+		err = rows.Scan(&sub.ID, &sub.URL, &sub.Secret, &sub.Address, &sub.NetworkID, &eventTypes, &sub.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		for _, eventType := range eventTypes {
+			sub.EventTypes = append(sub.EventTypes, uint8(eventType))
+		}
+		subs = append(subs, &sub)
+	}
+	return subs, rows.Err()
+}
+
+// GetWebhookEvents gets the oldest webhook events and locks them until dbTx ends. The events locked by other
+// transactions are skipped.
+func (p *PostgresStorage) GetWebhookEvents(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookEvent, error) {
+	const getWebhookEventsSQL = `SELECT id, state, leaf_type, network_id, orig_net, orig_addr, amount, dest_net, dest_addr, block_num, deposit_cnt, tx_hash, metadata, sender, claim_tx_hash
+		FROM webhook.event ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, getWebhookEventsSQL, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]*etherman.WebhookEvent, 0, limit)
+	for rows.Next() {
+		var (
+			event               etherman.WebhookEvent
+			deposit             etherman.Deposit
+			amount              string
+			sender, claimTxHash []byte
+		)
+		err = rows.Scan(&event.ID, &event.State, &deposit.LeafType, &deposit.NetworkID, &deposit.OriginalNetwork, &deposit.OriginalAddress, &amount, &deposit.DestinationNetwork,
+			&deposit.DestinationAddress, &deposit.BlockNumber, &deposit.DepositCount, &deposit.TxHash, &deposit.Metadata, &sender, &claimTxHash)
+		if err != nil {
+			return nil, err
+		}
+		deposit.Amount, _ = new(big.Int).SetString(amount, 10) //nolint:gomnd
+		deposit.Sender = common.BytesToAddress(sender)
+		event.Deposit = &deposit
+		event.ClaimTxHash = common.BytesToHash(claimTxHash)
+		events = append(events, &event)
+	}
+	return events, rows.Err()
+}
+
+// DeleteWebhookEvents removes the webhook events once their deliveries are stored.
+func (p *PostgresStorage) DeleteWebhookEvents(ctx context.Context, ids []uint64, dbTx pgx.Tx) error {
+	const deleteWebhookEventsSQL = "DELETE FROM webhook.event WHERE id = ANY($1)"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, deleteWebhookEventsSQL, ids)
+	return err
+}
+
+// AddWebhookDelivery stores a delivery to be sent at its next attempt time.
+func (p *PostgresStorage) AddWebhookDelivery(ctx context.Context, delivery *etherman.WebhookDelivery, dbTx pgx.Tx) error {
+	const addWebhookDeliverySQL = "INSERT INTO webhook.delivery (subscription_id, event_type, payload, next_attempt_at) VALUES ($1, $2, $3, $4)"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, addWebhookDeliverySQL, delivery.SubscriptionID, int16(delivery.EventType), delivery.Payload, delivery.NextAttemptAt)
+	return err
+}
+
+// LeaseWebhookDeliveries gets the deliveries whose attempt is due at now, and moves their next attempt to leaseUntil
+// so they aren't sent again while they are being sent. The deliveries leased by other transactions are skipped.
+func (p *PostgresStorage) LeaseWebhookDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookDelivery, error) {
+	const leaseWebhookDeliveriesSQL = `UPDATE webhook.delivery AS d SET next_attempt_at = $2 FROM webhook.subscription AS s
+		WHERE d.id IN (SELECT id FROM webhook.delivery WHERE next_attempt_at <= $1 ORDER BY next_attempt_at LIMIT $3 FOR UPDATE SKIP LOCKED) AND s.id = d.subscription_id
+		RETURNING d.id, d.subscription_id, d.event_type, d.payload, d.attempts, d.next_attempt_at, COALESCE(d.last_error, ''), d.created_at, s.url, s.secret`
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, leaseWebhookDeliveriesSQL, now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := make([]*etherman.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		var (
+			delivery  etherman.WebhookDelivery
+			eventType int16
+		)
+		err = rows.Scan(&delivery.ID, &delivery.SubscriptionID, &eventType, &delivery.Payload, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastError, &delivery.CreatedAt, &delivery.URL, &delivery.Secret)
+		if err != nil {
+			return nil, err
+		}
+		delivery.EventType = uint8(eventType)
+		deliveries = append(deliveries, &delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// DeleteWebhookDelivery removes a delivery once it is sent.
+func (p *PostgresStorage) DeleteWebhookDelivery(ctx context.Context, id uint64, dbTx pgx.Tx) error {
+	const deleteWebhookDeliverySQL = "DELETE FROM webhook.delivery WHERE id = $1"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, deleteWebhookDeliverySQL, id)
+	return err
+}
+
+// RetryWebhookDelivery records a failed attempt of a delivery and schedules the next one.
+func (p *PostgresStorage) RetryWebhookDelivery(ctx context.Context, id uint64, nextAttemptAt time.Time, lastError string, dbTx pgx.Tx) error {
+	const retryWebhookDeliverySQL = "UPDATE webhook.delivery SET attempts = attempts + 1, next_attempt_at = $2, last_error = $3 WHERE id = $1"
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, retryWebhookDeliverySQL, id, nextAttemptAt, lastError)
+	return err
+}
+
+// DeadLetterWebhookDelivery records the last failed attempt of a delivery and moves it to the dead letters.
+func (p *PostgresStorage) DeadLetterWebhookDelivery(ctx context.Context, id uint64, lastError string, dbTx pgx.Tx) error {
+	const deadLetterWebhookDeliverySQL = `WITH d AS (DELETE FROM webhook.delivery WHERE id = $1 RETURNING id, subscription_id, event_type, payload, attempts, created_at)
+		INSERT INTO webhook.dead_letter (id, subscription_id, event_type, payload, attempts, last_error, created_at)
+		SELECT id, subscription_id, event_type, payload, attempts + 1, $2, created_at FROM d`
+	_, err := p.getExecQuerier(dbTx).Exec(ctx, deadLetterWebhookDeliverySQL, id, lastError)
+	return err
+}
+
+// webhookDeadLetterConditions returns the conditions of the dead letters of the subscription with the ids. The empty
+// values match all of them.
+func webhookDeadLetterConditions(subscriptionID *uint64, ids []uint64) *sqlConditions {
+	c := &sqlConditions{}
+	if subscriptionID != nil {
+		c.add("subscription_id = %s", *subscriptionID)
+	}
+	if len(ids) > 0 {
+		dbIDs := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			dbIDs = append(dbIDs, int64(id))
+		}
+		c.add("id = ANY(%s)", dbIDs)
+	}
+	return c
+}
+
+// GetWebhookDeadLetters gets the dead letters of a subscription, or of all of them if the subscription is nil,
+// ordered from the newest one.
+func (p *PostgresStorage) GetWebhookDeadLetters(ctx context.Context, subscriptionID *uint64, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.WebhookDelivery, error) {
+	const getWebhookDeadLettersSQL = "SELECT id, subscription_id, event_type, payload, attempts, COALESCE(last_error, ''), created_at, failed_at FROM webhook.dead_letter"
+	conditions := webhookDeadLetterConditions(subscriptionID, nil)
+	sql := getWebhookDeadLettersSQL + conditions.where() + " ORDER BY id DESC LIMIT " + conditions.placeholder(limit) + " OFFSET " + conditions.placeholder(offset)
+	rows, err := p.getExecQuerier(dbTx).Query(ctx, sql, conditions.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deadLetters := make([]*etherman.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		var (
+			deadLetter etherman.WebhookDelivery
+			eventType  int16
+		)
+		err = rows.Scan(&deadLetter.ID, &deadLetter.SubscriptionID, &eventType, &deadLetter.Payload, &deadLetter.Attempts, &deadLetter.LastError, &deadLetter.CreatedAt, &deadLetter.FailedAt)
+		if err != nil {
+			return nil, err
+		}
+		deadLetter.EventType = uint8(eventType)
+		deadLetters = append(deadLetters, &deadLetter)
+	}
+	return deadLetters, rows.Err()
+}
+
+// ReplayWebhookDeadLetters moves the dead letters of the subscription with the ids back to the deliveries, to be sent
+// at nextAttemptAt with all their attempts. The deliveries keep their ids. It returns the number of replayed letters.
+func (p *PostgresStorage) ReplayWebhookDeadLetters(ctx context.Context, subscriptionID *uint64, ids []uint64, nextAttemptAt time.Time, dbTx pgx.Tx) (uint64, error) {
+	const (
+		deleteDeadLettersSQL = "DELETE FROM webhook.dead_letter"
+		replaySQL            = ` RETURNING id, subscription_id, event_type, payload, created_at)
+		INSERT INTO webhook.delivery (id, subscription_id, event_type, payload, next_attempt_at, created_at)
+		SELECT id, subscription_id, event_type, payload, %s, created_at FROM d`
+	)
+	conditions := webhookDeadLetterConditions(subscriptionID, ids)
+	sql := "WITH d AS (" + deleteDeadLettersSQL + conditions.where() + fmt.Sprintf(replaySQL, conditions.placeholder(nextAttemptAt))
+	tag, err := p.getExecQuerier(dbTx).Exec(ctx, sql, conditions.args...)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(tag.RowsAffected()), nil
+}
@@ -1,14 +1,22 @@
 package db
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"math/big"
 	"testing"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/db/pgstorage"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -58,6 +66,14 @@ func TestL1GlobalExitRoot(t *testing.T) {
 	require.Equal(t, latestGER.ExitRoots[0], l1GER.ExitRoots[0])
 	require.Equal(t, latestGER.ExitRoots[1], l1GER.ExitRoots[1])
 
+	gerByRoot, err := pg.GetExitRootByGlobalExitRoot(ctx, l1GER.GlobalExitRoot, tx)
+	require.NoError(t, err)
+	require.Equal(t, gerByRoot.BlockID, l1GER.BlockID)
+	require.Equal(t, gerByRoot.ExitRoots, l1GER.ExitRoots)
+
+	_, err = pg.GetExitRootByGlobalExitRoot(ctx, common.HexToHash("0x01"), tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
 	require.NoError(t, tx.Commit(ctx))
 }
 
@@ -413,6 +429,7 @@ func TestBSStorage(t *testing.T) {
 		BlockNumber:        1,
 		BlockID:            1,
 		DepositCount:       1,
+		Sender:             common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
 		Metadata:           common.FromHex("0x000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000000c0000000000000000000000000000000000000000000000000000000000000005436f696e410000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000003434f410000000000000000000000000000000000000000000000000000000000"),
 	}
 	err = pg.AddDeposit(ctx, deposit, tx)
@@ -428,11 +445,12 @@ func TestBSStorage(t *testing.T) {
 		BlockNumber:        2,
 		NetworkID:          0,
 		TxHash:             common.HexToHash("0x29e885edaf8e4b51e1d2e05f9da28161d2fb4f6b1d53827d9b80a23cf2d7d9f2"),
+		SourceNetwork:      1,
 	}
 	err = pg.AddClaim(ctx, claim, tx)
 	require.NoError(t, err)
 
-	count, err := pg.GetDepositCount(ctx, deposit.DestinationAddress.String(), tx)
+	count, err := pg.GetDepositCount(ctx, &etherman.DepositFilter{DestinationAddress: &deposit.DestinationAddress}, tx)
 	require.NoError(t, err)
 	require.Equal(t, count, uint64(1))
 
@@ -440,11 +458,82 @@ func TestBSStorage(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, rDeposit.DestinationAddress, deposit.DestinationAddress)
 	require.Equal(t, rDeposit.DepositCount, deposit.DepositCount)
+	require.Equal(t, rDeposit.Sender, deposit.Sender)
 
-	rDeposits, err := pg.GetDeposits(ctx, deposit.DestinationAddress.String(), 10, 0, tx)
+	rDeposits, err := pg.GetDeposits(ctx, &etherman.DepositFilter{DestinationAddress: &deposit.DestinationAddress}, nil, 10, 0, tx)
 	require.NoError(t, err)
 	require.Equal(t, len(rDeposits), 1)
 
+	depositCnt, err := pg.GetNetworkDepositCount(ctx, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), depositCnt)
+	depositCnt, err = pg.GetNetworkDepositCount(ctx, 1, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint(0), depositCnt)
+
+	// The deposit count is consistent with the filtered deposits
+	var (
+		networkID, otherNetworkID = uint(0), uint(1)
+		leafType                  = uint8(0)
+		fromBlock, toBlock        = uint64(1), uint64(1)
+		fromTime                  = block.ReceivedAt.Add(-time.Minute)
+	)
+	filters := []struct {
+		filter   *etherman.DepositFilter
+		expected int
+	}{
+		{&etherman.DepositFilter{}, 1},
+		{&etherman.DepositFilter{NetworkID: &networkID, DestinationNetwork: &otherNetworkID}, 1},
+		{&etherman.DepositFilter{NetworkID: &otherNetworkID}, 0},
+		{&etherman.DepositFilter{OriginalNetwork: &networkID, OriginalAddress: &deposit.OriginalAddress, LeafType: &leafType}, 1},
+		{&etherman.DepositFilter{OriginalAddress: &claim.DestinationAddress}, 0},
+		{&etherman.DepositFilter{FromBlock: &fromBlock, ToBlock: &toBlock, FromTime: &fromTime}, 1},
+		{&etherman.DepositFilter{ToTime: &fromTime}, 0},
+		{&etherman.DepositFilter{Sender: &deposit.Sender}, 1},
+		{&etherman.DepositFilter{Sender: &deposit.DestinationAddress}, 0},
+		{&etherman.DepositFilter{Status: etherman.DepositStatusPending}, 1},
+		{&etherman.DepositFilter{Status: etherman.DepositStatusReadyForClaim}, 0},
+		{&etherman.DepositFilter{Status: etherman.DepositStatusClaimed}, 0},
+	}
+	for _, f := range filters {
+		count, err = pg.GetDepositCount(ctx, f.filter, tx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(f.expected), count)
+		rDeposits, err = pg.GetDeposits(ctx, f.filter, nil, 10, 0, tx)
+		require.NoError(t, err)
+		require.Equal(t, f.expected, len(rDeposits))
+	}
+
+	// The status of the deposits is read from their state
+	require.NoError(t, pg.UpdateDepositStates(ctx, 0, 0, 2, etherman.DepositStateClaimable, 0, time.Now(), tx))
+	statuses := map[etherman.DepositStatus]uint64{
+		etherman.DepositStatusPending:       0,
+		etherman.DepositStatusReadyForClaim: 1,
+		etherman.DepositStatusClaimed:       0,
+	}
+	for status, expected := range statuses {
+		count, err = pg.GetDepositCount(ctx, &etherman.DepositFilter{Status: status}, tx)
+		require.NoError(t, err)
+		require.Equal(t, expected, count)
+	}
+	readyCnt, err := pg.GetReadyDepositCount(ctx, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint(2), readyCnt)
+	rDeposit, err = pg.GetDeposit(ctx, 1, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, etherman.DepositStateClaimable, rDeposit.State)
+
+	// The senders of the stored deposits are backfilled
+	rDeposits, err = pg.GetDepositsWithoutSender(ctx, 0, 10, tx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rDeposits))
+	err = pg.UpdateDepositSender(ctx, 0, 1, deposit.DestinationAddress, tx)
+	require.NoError(t, err)
+	rDeposit, err = pg.GetDeposit(ctx, 1, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, deposit.DestinationAddress, rDeposit.Sender)
+	require.ErrorIs(t, pg.UpdateDepositSender(ctx, 0, 2, deposit.DestinationAddress, tx), gerror.ErrStorageNotFound)
+
 	count, err = pg.GetNumberDeposits(ctx, 0, 0, tx)
 	require.NoError(t, err)
 	require.Equal(t, count, uint64(0))
@@ -456,15 +545,61 @@ func TestBSStorage(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, count, uint64(1))
 
-	rClaim, err := pg.GetClaim(ctx, 1, 0, tx)
+	rClaim, err := pg.GetClaim(ctx, 1, 0, 1, tx)
 	require.NoError(t, err)
 	require.Equal(t, rClaim.DestinationAddress, claim.DestinationAddress)
 	require.Equal(t, rClaim.NetworkID, claim.NetworkID)
 	require.Equal(t, rClaim.Index, claim.Index)
+	require.Equal(t, rClaim.SourceNetwork, claim.SourceNetwork)
+	// The claim of a deposit with the same count from another network isn't linked
+	_, err = pg.GetClaim(ctx, 1, 0, 2, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	// The claim is linked to the deposit with the same leaf
+	depositClaim := &etherman.Claim{
+		Index:              deposit.DepositCount,
+		OriginalNetwork:    deposit.OriginalNetwork,
+		OriginalAddress:    deposit.OriginalAddress,
+		Amount:             deposit.Amount,
+		DestinationAddress: deposit.DestinationAddress,
+		NetworkID:          deposit.DestinationNetwork,
+	}
+	sourceNetwork, err := pg.GetClaimedDepositNetwork(ctx, depositClaim, tx)
+	require.NoError(t, err)
+	require.Equal(t, deposit.NetworkID, sourceNetwork)
+	depositClaim.Amount = big.NewInt(1)
+	_, err = pg.GetClaimedDepositNetwork(ctx, depositClaim, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	rClaims, err := pg.GetClaims(ctx, claim.DestinationAddress.String(), nil, 10, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, len(rClaims), 1)
+
+	// The deposits and claims are found by their transaction
+	rDeposits, err = pg.GetDepositsByTxHash(ctx, deposit.TxHash, tx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rDeposits))
+	require.Equal(t, deposit.DepositCount, rDeposits[0].DepositCount)
+	rClaims, err = pg.GetClaimsByTxHash(ctx, claim.TxHash, tx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rClaims))
+	require.Equal(t, block.BlockNumber, rClaims[0].BlockNumber)
+	_, err = pg.GetClaimsByTxHash(ctx, deposit.TxHash, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
 
-	rClaims, err := pg.GetClaims(ctx, claim.DestinationAddress.String(), 10, 0, tx)
+	// The pages start after the cursor
+	rClaims, err = pg.GetClaims(ctx, claim.DestinationAddress.String(), &etherman.Cursor{BlockID: 1, NetworkID: 0, Index: 1}, 10, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, len(rClaims), 0)
+	rClaims, err = pg.GetClaims(ctx, claim.DestinationAddress.String(), &etherman.Cursor{BlockID: 1, NetworkID: 0, Index: 2}, 10, 0, tx)
 	require.NoError(t, err)
 	require.Equal(t, len(rClaims), 1)
+	rDeposits, err = pg.GetDeposits(ctx, nil, &etherman.Cursor{BlockID: 1, NetworkID: 0, Index: 1}, 10, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, len(rDeposits), 0)
+	rDeposits, err = pg.GetDeposits(ctx, nil, &etherman.Cursor{BlockID: 2, NetworkID: 0, Index: 0}, 10, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, len(rDeposits), 1)
 
 	wrappedToken := &etherman.TokenWrapped{
 		OriginalNetwork:      0,
@@ -481,12 +616,545 @@ func TestBSStorage(t *testing.T) {
 	err = pg.AddTokenWrapped(ctx, wrappedToken, tx)
 	require.NoError(t, err)
 
-	wt, err := pg.GetTokenWrapped(ctx, wrappedToken.OriginalNetwork, wrappedToken.OriginalTokenAddress, tx)
+	wt, err := pg.GetTokenWrapped(ctx, wrappedToken.OriginalNetwork, wrappedToken.OriginalTokenAddress, &wrappedToken.NetworkID, tx)
 	require.NoError(t, err)
 	require.Equal(t, wt.WrappedTokenAddress, wrappedToken.WrappedTokenAddress)
 	require.Equal(t, wt.TokenMetadata.Name, "CoinA")
 	require.Equal(t, wt.TokenMetadata.Symbol, "COA")
 	require.Equal(t, wt.TokenMetadata.Decimals, uint8(12))
+	// The token is only wrapped in its network
+	otherNetwork := uint(2)
+	_, err = pg.GetTokenWrapped(ctx, wrappedToken.OriginalNetwork, wrappedToken.OriginalTokenAddress, &otherNetwork, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+	// Without the network it's found in any network
+	wt, err = pg.GetTokenWrapped(ctx, wrappedToken.OriginalNetwork, wrappedToken.OriginalTokenAddress, nil, tx)
+	require.NoError(t, err)
+	require.Equal(t, wrappedToken.WrappedTokenAddress, wt.WrappedTokenAddress)
+
+	wt, err = pg.GetTokenWrappedByAddress(ctx, wrappedToken.WrappedTokenAddress, wrappedToken.NetworkID, tx)
+	require.NoError(t, err)
+	require.Equal(t, wt.OriginalTokenAddress, wrappedToken.OriginalTokenAddress)
+	_, err = pg.GetTokenWrappedByAddress(ctx, wrappedToken.WrappedTokenAddress, 0, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	tokenNetworkID := wrappedToken.NetworkID
+	tokenFilter := &etherman.TokenWrappedFilter{NetworkID: &tokenNetworkID, Symbol: "coa"}
+	tokenCount, err := pg.GetTokenWrappedCount(ctx, tokenFilter, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), tokenCount)
+	tokens, err := pg.GetTokensWrapped(ctx, tokenFilter, 10, 0, tx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, wrappedToken.WrappedTokenAddress, tokens[0].WrappedTokenAddress)
+	tokens, err = pg.GetTokensWrapped(ctx, &etherman.TokenWrappedFilter{Symbol: "COB"}, 10, 0, tx)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
 
 	require.NoError(t, tx.Commit(ctx))
 }
+
+func TestSnapshot(t *testing.T) {
+	// Init database instance
+	cfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	pg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+
+	block := &etherman.Block{
+		BlockNumber: 10,
+		BlockHash:   common.HexToHash("0x29e885edaf8e4b51e1d2e05f9da28161d2fb4f6b1d53827d9b80a23cf2d7d9f1"),
+		ParentHash:  common.HexToHash("0x29e885edaf8e4b51e1d2e05f9da28161d2fb4f6b1d53827d9b80a23cf2d7d9f2"),
+		NetworkID:   0,
+		ReceivedAt:  time.Now(),
+	}
+	blockID, err := pg.AddBlock(ctx, block, nil)
+	require.NoError(t, err)
+	deposit := &etherman.Deposit{
+		OriginalAddress:    common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F"),
+		Amount:             big.NewInt(1000000),
+		DestinationNetwork: 1,
+		DestinationAddress: common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		BlockID:            blockID,
+		Metadata:           []byte{},
+	}
+	require.NoError(t, pg.AddDeposit(ctx, deposit, nil))
+	require.NoError(t, pg.SetRoot(ctx, common.HexToHash("0x1").Bytes(), 1, 0, nil))
+
+	var archive bytes.Buffer
+	manifest, err := pg.ExportSnapshot(ctx, &archive)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), manifest.LastBlocks[0])
+
+	// The snapshot can only be imported into an empty database
+	_, err = pg.ImportSnapshot(ctx, bytes.NewReader(archive.Bytes()), nil)
+	require.Error(t, err)
+
+	require.NoError(t, pgstorage.InitOrReset(cfg))
+	// A corrupted snapshot isn't imported
+	corrupted := archiveWithManifest(t, archive.Bytes(), func(m *pgstorage.SnapshotManifest) { m.Tables[0].Checksum = "00" })
+	_, err = pg.ImportSnapshot(ctx, bytes.NewReader(corrupted), nil)
+	require.Error(t, err)
+	_, err = pg.GetLastBlock(ctx, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+	// The snapshot isn't imported if the restored data doesn't pass the verification
+	_, err = pg.ImportSnapshot(ctx, bytes.NewReader(archive.Bytes()), func(dbTx pgx.Tx) error {
+		_, err := pg.GetDeposit(ctx, 0, 0, dbTx)
+		require.NoError(t, err)
+		return errors.New("the exit tree diverges")
+	})
+	require.Error(t, err)
+	_, err = pg.GetLastBlock(ctx, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	imported, err := pg.ImportSnapshot(ctx, bytes.NewReader(archive.Bytes()), nil)
+	require.NoError(t, err)
+	assert.Equal(t, manifest.Tables, imported.Tables)
+	lastBlock, err := pg.GetLastBlock(ctx, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), lastBlock.BlockNumber)
+	rDeposit, err := pg.GetDeposit(ctx, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, deposit.DestinationAddress, rDeposit.DestinationAddress)
+	root, err := pg.GetRoot(ctx, 1, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash("0x1").Bytes(), root)
+
+	// The ids continue after the restored ones
+	block.BlockNumber = 11
+	newBlockID, err := pg.AddBlock(ctx, block, nil)
+	require.NoError(t, err)
+	assert.Equal(t, blockID+1, newBlockID)
+
+	// The imported rows can be removed to import the snapshot again
+	require.NoError(t, pg.DeleteSnapshot(ctx))
+	_, err = pg.GetLastBlock(ctx, 0, nil)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+	_, err = pg.ImportSnapshot(ctx, bytes.NewReader(archive.Bytes()), nil)
+	require.NoError(t, err)
+}
+
+// archiveWithManifest rewrites the manifest of a snapshot archive.
+func archiveWithManifest(t *testing.T, archive []byte, modify func(m *pgstorage.SnapshotManifest)) []byte {
+	gzr, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+	var out bytes.Buffer
+	gzw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gzw)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		if header.Name == "manifest.json" {
+			var manifest pgstorage.SnapshotManifest
+			require.NoError(t, json.Unmarshal(data, &manifest))
+			modify(&manifest)
+			data, err = json.Marshal(manifest)
+			require.NoError(t, err)
+			header.Size = int64(len(data))
+		}
+		require.NoError(t, tw.WriteHeader(header))
+		_, err = tw.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return out.Bytes()
+}
+
+func TestWebhookStorage(t *testing.T) {
+	// Init database instance
+	cfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	pg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+
+	addr := common.HexToAddress("0x01")
+	networkID := uint(1)
+	subID, err := pg.AddWebhookSubscription(ctx, &etherman.WebhookSubscription{URL: "http://localhost", Secret: "secret", Address: addr, NetworkID: &networkID, EventTypes: []uint8{2, 3}}, nil)
+	require.NoError(t, err)
+	allID, err := pg.AddWebhookSubscription(ctx, &etherman.WebhookSubscription{URL: "http://localhost", Secret: "secret", Address: addr, EventTypes: []uint8{3}}, nil)
+	require.NoError(t, err)
+
+	// The subscriptions without network match all the networks
+	subs, err := pg.GetMatchingWebhookSubscriptions(ctx, addr, 1, 3, nil)
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+	require.Equal(t, &networkID, subs[0].NetworkID)
+	require.Equal(t, []uint8{2, 3}, subs[0].EventTypes)
+	subs, err = pg.GetMatchingWebhookSubscriptions(ctx, addr, 0, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, subs, 0)
+
+	now := time.Now()
+	for _, id := range []uint64{subID, allID} {
+		err = pg.AddWebhookDelivery(ctx, &etherman.WebhookDelivery{SubscriptionID: id, EventType: 3, Payload: []byte("{}"), NextAttemptAt: now}, nil)
+		require.NoError(t, err)
+	}
+	deliveries, err := pg.LeaseWebhookDeliveries(ctx, now, now.Add(time.Minute), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, "secret", deliveries[0].Secret)
+	// The leased deliveries aren't due until the lease ends
+	leased, err := pg.LeaseWebhookDeliveries(ctx, now, now.Add(time.Minute), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, leased, 0)
+
+	require.NoError(t, pg.DeleteWebhookDelivery(ctx, deliveries[0].ID, nil))
+	require.NoError(t, pg.RetryWebhookDelivery(ctx, deliveries[1].ID, now, "error", nil))
+	deliveries, err = pg.LeaseWebhookDeliveries(ctx, now, now.Add(time.Minute), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, uint(1), deliveries[0].Attempts)
+	require.Equal(t, "error", deliveries[0].LastError)
+
+	require.NoError(t, pg.DeadLetterWebhookDelivery(ctx, deliveries[0].ID, "last error", nil))
+	deadLetters, err := pg.GetWebhookDeadLetters(ctx, &deliveries[0].SubscriptionID, 10, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, deliveries[0].ID, deadLetters[0].ID)
+	require.Equal(t, uint(2), deadLetters[0].Attempts)
+	require.Equal(t, "last error", deadLetters[0].LastError)
+
+	// The replayed deliveries keep their ids
+	replayed, err := pg.ReplayWebhookDeadLetters(ctx, nil, []uint64{deadLetters[0].ID}, now, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), replayed)
+	deliveries, err = pg.LeaseWebhookDeliveries(ctx, now, now.Add(time.Minute), 10, nil)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, deadLetters[0].ID, deliveries[0].ID)
+	require.Equal(t, uint(0), deliveries[0].Attempts)
+
+	// The deliveries are removed with their subscription
+	require.NoError(t, pg.DeleteWebhookSubscription(ctx, allID, nil))
+	require.ErrorIs(t, pg.DeleteWebhookSubscription(ctx, allID, nil), gerror.ErrStorageNotFound)
+	subs, err = pg.GetWebhookSubscriptions(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+
+	// The transitions of the deposits to the subscribed addresses are stored as events
+	blockID, err := pg.AddBlock(ctx, &etherman.Block{BlockNumber: 7, BlockHash: common.HexToHash("0x07"), ReceivedAt: time.Now()}, nil)
+	require.NoError(t, err)
+	for i, destAddr := range []common.Address{addr, common.HexToAddress("0x02")} {
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x03"),
+			Amount:             big.NewInt(1),
+			DestinationNetwork: 1,
+			DestinationAddress: destAddr,
+			BlockID:            blockID,
+			DepositCount:       uint(i),
+			Metadata:           []byte{},
+		}
+		require.NoError(t, pg.AddDeposit(ctx, deposit, nil))
+	}
+	require.NoError(t, pg.UpdateDepositStates(ctx, 0, 0, 2, etherman.DepositStateIndexed, blockID, time.Time{}, nil))
+	events, err := pg.GetWebhookEvents(ctx, 10, nil)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, etherman.DepositStateIndexed, events[0].State)
+	require.Equal(t, addr, events[0].Deposit.DestinationAddress)
+	require.Equal(t, uint64(7), events[0].Deposit.BlockNumber)
+	require.Equal(t, big.NewInt(1), events[0].Deposit.Amount)
+	require.NoError(t, pg.DeleteWebhookEvents(ctx, []uint64{events[0].ID}, nil))
+	events, err = pg.GetWebhookEvents(ctx, 10, nil)
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestDepositTransitions(t *testing.T) {
+	// Init database instance
+	cfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	pg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+	tx, err := pg.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+
+	depositBlock := &etherman.Block{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x01"),
+		NetworkID:   0,
+		ReceivedAt:  time.Now(),
+	}
+	depositBlockID, err := pg.AddBlock(ctx, depositBlock, tx)
+	require.NoError(t, err)
+	claimBlock := &etherman.Block{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x02"),
+		NetworkID:   1,
+		ReceivedAt:  time.Now(),
+	}
+	claimBlockID, err := pg.AddBlock(ctx, claimBlock, tx)
+	require.NoError(t, err)
+
+	for i := uint(0); i < 2; i++ {
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x03"),
+			Amount:             big.NewInt(1),
+			DestinationNetwork: 1,
+			DestinationAddress: common.HexToAddress("0x04"),
+			BlockID:            depositBlockID,
+			DepositCount:       i,
+		}
+		require.NoError(t, pg.AddDeposit(ctx, deposit, tx))
+	}
+	observedAt := time.Now().Truncate(time.Second)
+	require.NoError(t, pg.UpdateDepositStates(ctx, 0, 0, 2, etherman.DepositStateIndexed, depositBlockID, time.Time{}, tx))
+	// The deposits only move forward
+	require.NoError(t, pg.UpdateDepositStates(ctx, 0, 0, 1, etherman.DepositStateClaimable, 0, observedAt, tx))
+	require.NoError(t, pg.UpdateDepositStates(ctx, 0, 0, 2, etherman.DepositStateIncluded, depositBlockID, time.Time{}, tx))
+	depositCnt, err := pg.GetFirstNotReadyDepositCount(ctx, 0, tx)
+	require.NoError(t, err)
+	require.Equal(t, uint(1), depositCnt)
+	require.NoError(t, pg.UpdateDepositStates(ctx, 0, 1, 2, etherman.DepositStateClaimed, claimBlockID, time.Time{}, tx))
+	_, err = pg.GetFirstNotReadyDepositCount(ctx, 0, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	transitions, err := pg.GetDepositTransitions(ctx, 0, 0, tx)
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	require.Equal(t, etherman.DepositStateIndexed, transitions[0].State)
+	require.Equal(t, depositBlockID, transitions[0].DepositBlockID)
+	require.Equal(t, depositBlockID, transitions[0].BlockID)
+	require.Equal(t, depositBlock.BlockNumber, transitions[0].BlockNumber)
+	require.Equal(t, etherman.DepositStateClaimable, transitions[1].State)
+	require.Equal(t, uint64(0), transitions[1].BlockID)
+	require.True(t, observedAt.Equal(transitions[1].Time))
+
+	transitions, err = pg.GetDepositTransitions(ctx, 0, 1, tx)
+	require.NoError(t, err)
+	require.Len(t, transitions, 3)
+	require.Equal(t, etherman.DepositStateClaimed, transitions[2].State)
+	require.Equal(t, uint(1), transitions[2].BlockNetworkID)
+
+	// The reorg of the claim removes its transition and moves back the deposit
+	require.NoError(t, pg.Reset(ctx, 0, 1, tx))
+	transitions, err = pg.GetDepositTransitions(ctx, 0, 1, tx)
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	require.NoError(t, pg.UpdateDepositStates(ctx, 0, 1, 2, etherman.DepositStateClaimable, 0, time.Time{}, tx))
+	transitions, err = pg.GetDepositTransitions(ctx, 0, 1, tx)
+	require.NoError(t, err)
+	require.Len(t, transitions, 3)
+	// The time of the transitions which weren't observed is unknown
+	require.True(t, transitions[2].Time.IsZero())
+
+	_, err = pg.GetDepositTransitions(ctx, 0, 2, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+	require.NoError(t, tx.Rollback(ctx))
+}
+
+func TestClaimableLatencies(t *testing.T) {
+	// Init database instance
+	cfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	pg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+	tx, err := pg.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Second)
+	depositBlockID, err := pg.AddBlock(ctx, &etherman.Block{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x01"),
+		NetworkID:   1,
+		ReceivedAt:  now.Add(-10 * time.Minute),
+	}, tx)
+	require.NoError(t, err)
+	verifyBlockID, err := pg.AddBlock(ctx, &etherman.Block{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x02"),
+		NetworkID:   0,
+		ReceivedAt:  now,
+	}, tx)
+	require.NoError(t, err)
+
+	for i := uint(0); i < 5; i++ {
+		deposit := &etherman.Deposit{
+			OriginalAddress:    common.HexToAddress("0x03"),
+			Amount:             big.NewInt(1),
+			DestinationNetwork: 0,
+			DestinationAddress: common.HexToAddress("0x04"),
+			BlockID:            depositBlockID,
+			DepositCount:       i,
+			NetworkID:          1,
+		}
+		require.NoError(t, pg.AddDeposit(ctx, deposit, tx))
+	}
+	require.NoError(t, pg.UpdateDepositStates(ctx, 1, 0, 5, etherman.DepositStateIndexed, depositBlockID, time.Time{}, tx))
+	require.NoError(t, pg.UpdateDepositStates(ctx, 1, 0, 2, etherman.DepositStateClaimable, verifyBlockID, time.Time{}, tx))
+	// The transitions observed live are sampled, the ones reached while the service was stopped aren't
+	require.NoError(t, pg.UpdateDepositStates(ctx, 1, 2, 3, etherman.DepositStateClaimable, 0, now.Add(-5*time.Minute), tx))
+	require.NoError(t, pg.UpdateDepositStates(ctx, 1, 3, 4, etherman.DepositStateClaimable, 0, time.Time{}, tx))
+	// The transitions backfilled by the migrations have no creation time
+	_, err = tx.Exec(ctx, "INSERT INTO syncv2.deposit_transition (network_id, deposit_cnt, deposit_block_id, state, block_id, created_at) VALUES (1, 4, $1, $2, $3, NULL)", depositBlockID, etherman.DepositStateClaimable, verifyBlockID)
+	require.NoError(t, err)
+
+	latencies, err := pg.GetClaimableLatencies(ctx, now.Add(-time.Hour), tx)
+	require.NoError(t, err)
+	require.Len(t, latencies, 1)
+	require.Equal(t, uint(1), latencies[0].NetworkID)
+	require.Equal(t, uint64(3), latencies[0].Samples)
+	require.Equal(t, 10*time.Minute, latencies[0].P50)
+
+	// The deposits before the window are not included
+	latencies, err = pg.GetClaimableLatencies(ctx, now.Add(-time.Minute), tx)
+	require.NoError(t, err)
+	require.Empty(t, latencies)
+
+	deposit, err := pg.GetDeposit(ctx, 2, 1, tx)
+	require.NoError(t, err)
+	require.True(t, now.Add(-10*time.Minute).Equal(deposit.ReceivedAt))
+	require.NoError(t, tx.Rollback(ctx))
+}
+
+func TestTokenStats(t *testing.T) {
+	// Init database instance
+	cfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	pg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+	tx, err := pg.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	token := common.HexToAddress("0x03")
+	oldBlockID, err := pg.AddBlock(ctx, &etherman.Block{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x01"),
+		NetworkID:   0,
+		ReceivedAt:  now.Add(-48 * time.Hour),
+	}, tx)
+	require.NoError(t, err)
+	newBlockID, err := pg.AddBlock(ctx, &etherman.Block{
+		BlockNumber: 2,
+		BlockHash:   common.HexToHash("0x02"),
+		NetworkID:   0,
+		ReceivedAt:  now,
+	}, tx)
+	require.NoError(t, err)
+	claimBlockID, err := pg.AddBlock(ctx, &etherman.Block{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x03"),
+		NetworkID:   1,
+		ReceivedAt:  now,
+	}, tx)
+	require.NoError(t, err)
+
+	for i, blockID := range []uint64{oldBlockID, newBlockID, newBlockID} {
+		deposit := &etherman.Deposit{
+			OriginalAddress:    token,
+			Amount:             big.NewInt(10),
+			DestinationNetwork: 1,
+			DestinationAddress: common.HexToAddress("0x04"),
+			BlockID:            blockID,
+			DepositCount:       uint(i),
+		}
+		require.NoError(t, pg.AddDeposit(ctx, deposit, tx))
+	}
+	claim := &etherman.Claim{
+		Index:              0,
+		OriginalAddress:    token,
+		Amount:             big.NewInt(10),
+		DestinationAddress: common.HexToAddress("0x04"),
+		BlockID:            claimBlockID,
+		NetworkID:          1,
+	}
+	require.NoError(t, pg.AddClaim(ctx, claim, tx))
+	for _, blockID := range []uint64{oldBlockID, newBlockID, claimBlockID} {
+		require.NoError(t, pg.AddBlockStats(ctx, blockID, tx))
+	}
+
+	stats, err := pg.GetTokenStats(ctx, []time.Time{now.Add(-time.Hour)}, tx)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	mainnet, rollup := stats[0], stats[1]
+	require.Equal(t, uint(0), mainnet.NetworkID)
+	require.Equal(t, token, mainnet.OriginalAddress)
+	require.Equal(t, uint64(3), mainnet.Total.DepositCount)
+	require.Equal(t, "30", mainnet.Total.DepositAmount.String())
+	require.Equal(t, uint64(2), mainnet.Windows[0].DepositCount)
+	require.Equal(t, "20", mainnet.OutstandingAmount.String())
+	require.Equal(t, "30", mainnet.LockedAmount.String())
+	require.Equal(t, uint(1), rollup.NetworkID)
+	require.Equal(t, uint64(1), rollup.Total.ClaimCount)
+	require.Equal(t, "10", rollup.Windows[0].ClaimAmount.String())
+	// The tokens of other networks aren't locked
+	require.Equal(t, "0", rollup.LockedAmount.String())
+
+	// The reorg removes the deposits of the block from the stats
+	require.NoError(t, pg.Reset(ctx, 1, 0, tx))
+	stats, err = pg.GetTokenStats(ctx, []time.Time{now.Add(-time.Hour)}, tx)
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, uint64(1), stats[0].Total.DepositCount)
+	require.Equal(t, uint64(0), stats[0].Windows[0].DepositCount)
+	require.Equal(t, "0", stats[0].OutstandingAmount.String())
+	require.NoError(t, tx.Rollback(ctx))
+}
+
+func TestRollupExitLeaves(t *testing.T) {
+	// Init database instance
+	cfg := pgstorage.NewConfigFromEnv()
+	err := pgstorage.InitOrReset(cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+	pg, err := pgstorage.NewPostgresStorage(cfg)
+	require.NoError(t, err)
+	tx, err := pg.BeginDBTransaction(ctx)
+	require.NoError(t, err)
+
+	_, err = pg.GetLatestRollupExitLeaves(ctx, tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+
+	var (
+		rollupRoots = []common.Hash{common.HexToHash("0x0a"), common.HexToHash("0x0b")}
+		leaves      = [][]*etherman.RollupExitLeaf{
+			{{NetworkID: 1000, RollupIndex: 0, LocalExitRoot: common.HexToHash("0x01")}},
+			{
+				{NetworkID: 1001, RollupIndex: 1, LocalExitRoot: common.HexToHash("0x03")},
+				{NetworkID: 1000, RollupIndex: 0, LocalExitRoot: common.HexToHash("0x02")},
+			},
+		}
+	)
+	for i := range rollupRoots {
+		blockID, err := pg.AddBlock(ctx, &etherman.Block{
+			BlockNumber: uint64(i + 1),
+			BlockHash:   common.BigToHash(big.NewInt(int64(i + 1))),
+			ReceivedAt:  time.Now(),
+		}, tx)
+		require.NoError(t, err)
+		require.NoError(t, pg.AddRollupExitLeaves(ctx, rollupRoots[i], leaves[i], blockID, tx))
+	}
+
+	stored, err := pg.GetRollupExitLeaves(ctx, rollupRoots[1], tx)
+	require.NoError(t, err)
+	require.Equal(t, []*etherman.RollupExitLeaf{leaves[1][1], leaves[1][0]}, stored)
+	latest, err := pg.GetLatestRollupExitLeaves(ctx, tx)
+	require.NoError(t, err)
+	require.Equal(t, stored, latest)
+
+	// The leaves synced in a reorged block are removed with it
+	require.NoError(t, pg.Reset(ctx, 1, 0, tx))
+	_, err = pg.GetRollupExitLeaves(ctx, rollupRoots[1], tx)
+	require.ErrorIs(t, err, gerror.ErrStorageNotFound)
+	latest, err = pg.GetLatestRollupExitLeaves(ctx, tx)
+	require.NoError(t, err)
+	require.Equal(t, leaves[0], latest)
+	require.NoError(t, tx.Rollback(ctx))
+}
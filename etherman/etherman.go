@@ -292,6 +292,10 @@ func (etherMan *Client) depositEvent(ctx context.Context, vLog types.Log, blocks
 	deposit.TxHash = vLog.TxHash
 	deposit.Metadata = d.Metadata
 	deposit.LeafType = d.LeafType
+	deposit.Sender, err = etherMan.GetTxSender(ctx, vLog.TxHash)
+	if err != nil {
+		return fmt.Errorf("error getting the sender of the deposit. TxHash: %s. Error: %w", vLog.TxHash.String(), err)
+	}
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
@@ -329,6 +333,10 @@ func (etherMan *Client) claimEvent(ctx context.Context, vLog types.Log, blocks *
 	claim.OriginalAddress = c.OriginAddress
 	claim.BlockNumber = vLog.BlockNumber
 	claim.TxHash = vLog.TxHash
+	claim.RollupExitRoot, err = etherMan.claimRollupExitRoot(ctx, vLog)
+	if err != nil {
+		return err
+	}
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
@@ -352,6 +360,37 @@ func (etherMan *Client) claimEvent(ctx context.Context, vLog types.Log, blocks *
 	return nil
 }
 
+// claimRollupExitRoot decodes the rollup exit root from the calldata of the claim tx. The claims sent through other
+// contracts can't be decoded, and a zero hash is returned for them.
+func (etherMan *Client) claimRollupExitRoot(ctx context.Context, vLog types.Log) (common.Hash, error) {
+	tx, _, err := etherMan.EtherClient.TransactionByHash(ctx, vLog.TxHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	txData := tx.Data()
+	if tx.To() == nil || *tx.To() != vLog.Address || len(txData) < 4 { //nolint:gomnd
+		return common.Hash{}, nil
+	}
+	abi, err := abi.JSON(strings.NewReader(bridge.BridgeABI))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	method, err := abi.MethodById(txData[:4])
+	if err != nil || (method.Name != "claimAsset" && method.Name != "claimMessage") {
+		return common.Hash{}, nil
+	}
+	// Both methods receive (smtProof, index, mainnetExitRoot, rollupExitRoot, ...)
+	data, err := method.Inputs.Unpack(txData[4:])
+	if err != nil {
+		return common.Hash{}, err
+	}
+	rollupExitRoot, ok := data[3].([32]byte)
+	if !ok {
+		return common.Hash{}, fmt.Errorf("unexpected rollup exit root type %T in the claim tx %s", data[3], vLog.TxHash)
+	}
+	return rollupExitRoot, nil
+}
+
 func (etherMan *Client) tokenWrappedEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
 	log.Debug("TokenWrapped event detected")
 	tw, err := etherMan.Bridge.ParseNewWrappedToken(vLog)
@@ -431,6 +470,34 @@ func (etherMan *Client) sequencedBatchesEvent(ctx context.Context, vLog types.Lo
 	return nil
 }
 
+// decodeVerifiedLocalExitRoot returns the new local exit root of the trustedVerifyBatches call.
+func decodeVerifiedLocalExitRoot(txData []byte) (common.Hash, error) {
+	abi, err := abi.JSON(strings.NewReader(proofofefficiency.ProofofefficiencyABI))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(txData) < 4 { //nolint:gomnd
+		return common.Hash{}, fmt.Errorf("invalid tx data")
+	}
+	method, err := abi.MethodById(txData[:4])
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if method.Name != "trustedVerifyBatches" {
+		return common.Hash{}, fmt.Errorf("unexpected method %s", method.Name)
+	}
+	data, err := method.Inputs.Unpack(txData[4:])
+	if err != nil {
+		return common.Hash{}, err
+	}
+	// trustedVerifyBatches(pendingStateNum, initNumBatch, finalNewBatch, newLocalExitRoot, newStateRoot, proofA, proofB, proofC)
+	localExitRoot, ok := data[3].([32]byte)
+	if !ok {
+		return common.Hash{}, fmt.Errorf("invalid new local exit root")
+	}
+	return localExitRoot, nil
+}
+
 func decodeSequences(txData []byte, lastBatchNumber uint64, sequencer common.Address, txHash common.Hash) ([]SequencedBatch, error) {
 	// Extract coded txs.
 	// Load contract ABI
@@ -480,11 +547,23 @@ func (etherMan *Client) trustedVerifyBatchesEvent(ctx context.Context, vLog type
 	if err != nil {
 		return err
 	}
+	// The local exit root isn't in the event, it is read from the tx
+	tx, isPending, err := etherMan.EtherClient.TransactionByHash(ctx, vLog.TxHash)
+	if err != nil {
+		return err
+	} else if isPending {
+		return fmt.Errorf("error tx is still pending. TxHash: %s", tx.Hash().String())
+	}
+	localExitRoot, err := decodeVerifiedLocalExitRoot(tx.Data())
+	if err != nil {
+		return fmt.Errorf("error decoding the verified local exit root. TxHash: %s, error: %v", vLog.TxHash, err)
+	}
 	var trustedVerifyBatch VerifiedBatch
 	trustedVerifyBatch.BatchNumber = vb.NumBatch
 	trustedVerifyBatch.TxHash = vLog.TxHash
 	trustedVerifyBatch.Aggregator = vb.Aggregator
 	trustedVerifyBatch.StateRoot = vb.StateRoot
+	trustedVerifyBatch.LocalExitRoot = localExitRoot
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		fullBlock, err := etherMan.EtherClient.BlockByHash(ctx, vLog.BlockHash)
@@ -715,6 +794,15 @@ func (etherMan *Client) GetLatestBatchNumber() (uint64, error) {
 	return uint64(latestBatch), err
 }
 
+// GetTxSender gets the address which signed a transaction.
+func (etherMan *Client) GetTxSender(ctx context.Context, txHash common.Hash) (common.Address, error) {
+	tx, _, err := etherMan.EtherClient.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+}
+
 // GetNetworkID gets the network ID of the dedicated chain.
 func (etherMan *Client) GetNetworkID(ctx context.Context) (uint, error) {
 	networkID, err := etherMan.Bridge.NetworkID(&bind.CallOpts{Pending: false})
@@ -168,7 +168,8 @@ func TestVerifyBatchEvent(t *testing.T) {
 		proofC = [2]*big.Int{big.NewInt(1), big.NewInt(1)}
 		proofB = [2][2]*big.Int{proofC, proofC}
 	)
-	_, err = etherman.PoE.TrustedVerifyBatches(auth, 0, 0, 1, common.Hash{}, common.Hash{}, proofA, proofB, proofC)
+	localExitRoot := common.HexToHash("0x5ca1e")
+	_, err = etherman.PoE.TrustedVerifyBatches(auth, 0, 0, 1, localExitRoot, common.Hash{}, proofA, proofB, proofC)
 	require.NoError(t, err)
 
 	// Mine the tx in a block
@@ -185,6 +186,7 @@ func TestVerifyBatchEvent(t *testing.T) {
 	assert.Equal(t, uint64(1), blocks[1].VerifiedBatches[0].BatchNumber)
 	assert.NotEqual(t, common.Address{}, blocks[1].VerifiedBatches[0].Aggregator)
 	assert.NotEqual(t, common.Hash{}, blocks[1].VerifiedBatches[0].TxHash)
+	assert.Equal(t, localExitRoot, blocks[1].VerifiedBatches[0].LocalExitRoot)
 	assert.Equal(t, GlobalExitRootsOrder, order[blocks[1].BlockHash][0].Name)
 	assert.Equal(t, TrustedVerifyBatchOrder, order[blocks[1].BlockHash][1].Name)
 	assert.Equal(t, 0, order[blocks[1].BlockHash][0].Pos)
@@ -268,6 +270,7 @@ func TestBridgeEvents(t *testing.T) {
 	assert.Equal(t, big.NewInt(9000000000000000000), block[0].Deposits[0].Amount)
 	assert.Equal(t, uint(destNetwork), block[0].Deposits[0].DestinationNetwork)
 	assert.Equal(t, destinationAddr, block[0].Deposits[0].DestinationAddress)
+	assert.Equal(t, auth.From, block[0].Deposits[0].Sender)
 	assert.Equal(t, 1, len(block[0].GlobalExitRoots))
 
 	//Claim funds
@@ -302,4 +305,5 @@ func TestBridgeEvents(t *testing.T) {
 	assert.Equal(t, uint(0), block[0].Claims[0].Index)
 	assert.Equal(t, uint(0), block[0].Claims[0].OriginalNetwork)
 	assert.Equal(t, uint64(3), block[0].Claims[0].BlockNumber)
+	assert.Equal(t, rollupExitRoot, block[0].Claims[0].RollupExitRoot)
 }
@@ -77,6 +77,13 @@ type Deposit struct {
 	NetworkID          uint
 	TxHash             common.Hash
 	Metadata           []byte
+	// Sender is the address which sent the bridge transaction, it is zero for the deposits synced before it was
+	// stored and not backfilled yet
+	Sender common.Address
+	// ReceivedAt is the time of the block of the deposit, it is only read from the storage
+	ReceivedAt time.Time
+	// State is the current state of the lifecycle of the deposit, it is only read from the storage
+	State DepositState
 }
 
 // Claim struct
@@ -90,6 +97,11 @@ type Claim struct {
 	BlockNumber        uint64
 	NetworkID          uint
 	TxHash             common.Hash
+	// SourceNetwork is the network of the claimed deposit
+	SourceNetwork uint
+	// RollupExitRoot is the rollup exit root the claim was proven against, decoded from the claim calldata. It is
+	// zero if the claim wasn't sent directly to the bridge, and it isn't stored.
+	RollupExitRoot common.Hash
 }
 
 // TokenWrapped struct
@@ -119,6 +131,15 @@ type VerifiedBatch struct {
 	Aggregator  common.Address
 	StateRoot   common.Hash
 	TxHash      common.Hash
+	// LocalExitRoot is the local exit root of the rollup after the verified batch, it isn't stored
+	LocalExitRoot common.Hash
+}
+
+// RollupExitLeaf is the verified local exit root of a rollup, a leaf of the rollup exit tree
+type RollupExitLeaf struct {
+	NetworkID     uint
+	RollupIndex   uint
+	LocalExitRoot common.Hash
 }
 
 // TokenMetadata is a metadata of ERC20 token.
@@ -127,3 +148,171 @@ type TokenMetadata struct {
 	Symbol   string
 	Decimals uint8
 }
+
+// Network is an L2 network registered at runtime
+type Network struct {
+	NetworkID  uint
+	RPCURL     string
+	BridgeAddr common.Address
+	StartBlock uint64
+	// TreeIndex is the index of the exit tree of the network in the merkle tree store
+	TreeIndex uint8
+	Active    bool
+}
+
+// DepositStatus is the claim status of a deposit
+type DepositStatus uint8
+
+const (
+	// DepositStatusAny matches the deposits in any status
+	DepositStatusAny DepositStatus = iota
+	// DepositStatusPending is a deposit not included yet in the exit root used to claim it
+	DepositStatusPending
+	// DepositStatusReadyForClaim is a deposit which can be claimed in the destination network
+	DepositStatusReadyForClaim
+	// DepositStatusClaimed is a deposit claimed in the destination network
+	DepositStatusClaimed
+)
+
+// DepositState is a state of the lifecycle of a deposit. The states are ordered, a deposit only moves forward
+// except when its transitions are removed by a reorg.
+type DepositState uint8
+
+const (
+	// DepositStateIndexed is a synced deposit
+	DepositStateIndexed DepositState = iota + 1
+	// DepositStateIncluded is a deposit included in the local exit root of its network
+	DepositStateIncluded
+	// DepositStateExitRootOnL1 is a deposit included in a global exit root synced from L1
+	DepositStateExitRootOnL1
+	// DepositStateBatchVerified is a rollup deposit included in a verified batch
+	DepositStateBatchVerified
+	// DepositStateClaimable is a deposit included in the exit root used to claim it
+	DepositStateClaimable
+	// DepositStateClaimed is a deposit claimed in the destination network
+	DepositStateClaimed
+	// DepositStateReorged is a deposit removed by a reorg
+	DepositStateReorged
+)
+
+// DepositTransition is a change of the state of a deposit
+type DepositTransition struct {
+	NetworkID    uint
+	DepositCount uint
+	// DepositBlockID is the block of the deposit, which tells apart the deposits with the same count removed by a reorg
+	DepositBlockID uint64
+	State          DepositState
+	// BlockID is the block where the transition was synced, zero for the transitions synced from the trusted state
+	// and the reorgs. The block number and network are only known for the transitions with a block.
+	BlockID        uint64
+	BlockNumber    uint64
+	BlockNetworkID uint
+	// Time is the time of the block, or the time the transition was observed for the transitions without a block. It's
+	// zero when it's unknown
+	Time time.Time
+}
+
+// NetworkLatency is the distribution of the time the deposits of a network took to be claimable since their block
+type NetworkLatency struct {
+	NetworkID uint
+	// Samples is the number of deposits the distribution is computed from
+	Samples uint64
+	P10     time.Duration
+	P50     time.Duration
+	P90     time.Duration
+}
+
+// TokenVolume is the number and the amount of the deposits and the claims of a token
+type TokenVolume struct {
+	DepositCount  uint64
+	DepositAmount *big.Int
+	ClaimCount    uint64
+	ClaimAmount   *big.Int
+}
+
+// TokenStats are the statistics of a token in a network. The token is identified by its original network and address.
+type TokenStats struct {
+	NetworkID       uint
+	OriginalNetwork uint
+	OriginalAddress common.Address
+	// Total is the volume of all the synced blocks, and Windows the volume since each of the requested times
+	Total   TokenVolume
+	Windows []TokenVolume
+	// OutstandingAmount is the amount of the deposits of the network not claimed yet in their destination network
+	OutstandingAmount *big.Int
+	// LockedAmount is the amount held by the bridge of the network, the deposits minus the claims of the tokens
+	// originated in the network. It is zero for the wrapped tokens, which are burnt and minted instead.
+	LockedAmount *big.Int
+}
+
+// DepositFilter selects the deposits matching all the non empty fields
+type DepositFilter struct {
+	DestinationAddress *common.Address
+	Sender             *common.Address
+	NetworkID          *uint
+	DestinationNetwork *uint
+	OriginalNetwork    *uint
+	OriginalAddress    *common.Address
+	LeafType           *uint8
+	Status             DepositStatus
+	FromBlock          *uint64
+	ToBlock            *uint64
+	FromTime           *time.Time
+	ToTime             *time.Time
+}
+
+// TokenWrappedFilter selects the wrapped tokens matching all the non empty fields. The symbol is case insensitive.
+type TokenWrappedFilter struct {
+	NetworkID *uint
+	Symbol    string
+}
+
+// Cursor is the position of a deposit or a claim in the lists ordered from the newest one. The next page starts
+// after it. Index is the deposit count of the deposits and the index of the claims.
+type Cursor struct {
+	BlockID   uint64
+	NetworkID uint
+	Index     uint
+}
+
+// WebhookSubscription sends the events of the deposits to an address to a url. The events are signed with the secret.
+type WebhookSubscription struct {
+	ID  uint64
+	URL string
+	// Secret is the key of the HMAC signature of the events
+	Secret  string
+	Address common.Address
+	// NetworkID is the destination network of the deposits, nil matches all the networks
+	NetworkID *uint
+	// EventTypes are the types of the deposit events sent to the url
+	EventTypes []uint8
+	CreatedAt  time.Time
+}
+
+// WebhookEvent is a transition of a deposit to an address with webhook subscriptions, waiting to be moved to the
+// deliveries of the matching subscriptions.
+type WebhookEvent struct {
+	ID      uint64
+	State   DepositState
+	Deposit *Deposit
+	// ClaimTxHash is the hash of the claim of the deposit, it is zero if the deposit wasn't claimed
+	ClaimTxHash common.Hash
+}
+
+// WebhookDelivery is an event to be sent to a webhook subscription.
+type WebhookDelivery struct {
+	ID             uint64
+	SubscriptionID uint64
+	EventType      uint8
+	Payload        []byte
+	// Attempts is the number of failed attempts to send it
+	Attempts      uint
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	// FailedAt is the time of the last attempt of the dead letters
+	FailedAt time.Time
+	// URL and Secret are read from the subscription of the delivery
+	URL    string
+	Secret string
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/url"
+	"strings"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	bearerPrefix = "Bearer "
+	// defaultPageLimit and maxPageLimit are the page sizes of the admin lists
+	defaultPageLimit = 25
+	maxPageLimit     = 100
+)
+
+// NetworkManager registers and deregisters the L2 networks at runtime.
+type NetworkManager interface {
+	RegisterNetwork(ctx context.Context, rpcURL string, bridgeAddr common.Address, startBlock uint64) (*etherman.Network, error)
+	DeregisterNetwork(ctx context.Context, networkID uint) error
+	GetNetworks(ctx context.Context) ([]*etherman.Network, error)
+}
+
+// ProofCache provides the stats of the merkle proof cache.
+type ProofCache interface {
+	ProofCacheStats() bridgectrl.ProofCacheStats
+}
+
+// Webhooks manages the webhook subscriptions and their failed deliveries.
+type Webhooks interface {
+	AddSubscription(ctx context.Context, sub *etherman.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id uint64) error
+	GetSubscriptions(ctx context.Context) ([]*etherman.WebhookSubscription, error)
+	GetDeadLetters(ctx context.Context, subscriptionID *uint64, limit uint, offset uint) ([]*etherman.WebhookDelivery, error)
+	ReplayDeadLetters(ctx context.Context, subscriptionID *uint64, ids []uint64) (uint64, error)
+}
+
+type adminService struct {
+	networks   NetworkManager
+	proofCache ProofCache
+	webhooks   Webhooks
+	token      string
+	pb.UnimplementedAdminServiceServer
+}
+
+// newAdminService creates the admin service. The requests must send the token in the authorization header.
+// The webhook methods are unimplemented if webhooks is nil.
+func newAdminService(networks NetworkManager, proofCache ProofCache, webhooks Webhooks, token string) pb.AdminServiceServer {
+	return &adminService{
+		networks:   networks,
+		proofCache: proofCache,
+		webhooks:   webhooks,
+		token:      token,
+	}
+}
+
+// authorize checks the bearer token of the request.
+func (s *adminService) authorize(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get("authorization") {
+		token := strings.TrimPrefix(value, bearerPrefix)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1 {
+			return nil
+		}
+	}
+	return status.Error(codes.Unauthenticated, "invalid admin token")
+}
+
+// RegisterNetwork registers an L2 network and starts syncing it.
+func (s *adminService) RegisterNetwork(ctx context.Context, req *pb.RegisterNetworkRequest) (*pb.RegisterNetworkResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	if req.RpcUrl == "" || !common.IsHexAddress(req.BridgeAddr) {
+		return nil, status.Error(codes.InvalidArgument, "the rpc url and the bridge address are required")
+	}
+	network, err := s.networks.RegisterNetwork(ctx, req.RpcUrl, common.HexToAddress(req.BridgeAddr), req.StartBlock)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterNetworkResponse{
+		Network: networkToPb(network),
+	}, nil
+}
+
+// DeregisterNetwork stops syncing an L2 network registered at runtime.
+func (s *adminService) DeregisterNetwork(ctx context.Context, req *pb.DeregisterNetworkRequest) (*pb.DeregisterNetworkResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	err := s.networks.DeregisterNetwork(ctx, uint(req.NetId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeregisterNetworkResponse{}, nil
+}
+
+// GetNetworks returns the L2 networks registered at runtime.
+func (s *adminService) GetNetworks(ctx context.Context, req *pb.GetNetworksRequest) (*pb.GetNetworksResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	networks, err := s.networks.GetNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pbNetworks []*pb.Network
+	for _, network := range networks {
+		pbNetworks = append(pbNetworks, networkToPb(network))
+	}
+	return &pb.GetNetworksResponse{
+		Networks: pbNetworks,
+	}, nil
+}
+
+// GetProofCacheStats returns the stats of the merkle proof cache.
+func (s *adminService) GetProofCacheStats(ctx context.Context, req *pb.GetProofCacheStatsRequest) (*pb.GetProofCacheStatsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	stats := s.proofCache.ProofCacheStats()
+	return &pb.GetProofCacheStatsResponse{
+		Size:      uint64(stats.Size),
+		Entries:   uint64(stats.Entries),
+		Hits:      stats.Hits,
+		Misses:    stats.Misses,
+		Evictions: stats.Evictions,
+		HitRate:   stats.HitRate(),
+	}, nil
+}
+
+// CreateWebhookSubscription subscribes a url to the events of the deposits to an address.
+func (s *adminService) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.CreateWebhookSubscriptionResponse, error) {
+	if err := s.authorizeWebhooks(ctx); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Error(codes.InvalidArgument, "the url must be an absolute http or https url")
+	}
+	if !common.IsHexAddress(req.Address) {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	if len(req.EventTypes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "the event types are required")
+	}
+	sub := &etherman.WebhookSubscription{
+		URL:     req.Url,
+		Secret:  req.Secret,
+		Address: common.HexToAddress(req.Address),
+	}
+	if req.NetId != nil {
+		networkID := uint(*req.NetId)
+		sub.NetworkID = &networkID
+	}
+	for _, eventType := range req.EventTypes {
+		if eventType <= pb.DepositEventType_DEPOSIT_EVENT_TYPE_UNSPECIFIED || eventType > pb.DepositEventType_DEPOSIT_EVENT_TYPE_REMOVED {
+			return nil, status.Error(codes.InvalidArgument, "invalid event type")
+		}
+		sub.EventTypes = append(sub.EventTypes, uint8(eventType))
+	}
+	err = s.webhooks.AddSubscription(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateWebhookSubscriptionResponse{
+		Subscription: webhookSubscriptionToPb(sub),
+		Secret:       sub.Secret,
+	}, nil
+}
+
+// DeleteWebhookSubscription removes a webhook subscription with its pending deliveries.
+func (s *adminService) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	if err := s.authorizeWebhooks(ctx); err != nil {
+		return nil, err
+	}
+	err := s.webhooks.DeleteSubscription(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteWebhookSubscriptionResponse{}, nil
+}
+
+// GetWebhookSubscriptions returns the webhook subscriptions, without their secrets.
+func (s *adminService) GetWebhookSubscriptions(ctx context.Context, req *pb.GetWebhookSubscriptionsRequest) (*pb.GetWebhookSubscriptionsResponse, error) {
+	if err := s.authorizeWebhooks(ctx); err != nil {
+		return nil, err
+	}
+	subs, err := s.webhooks.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pbSubs []*pb.WebhookSubscription
+	for _, sub := range subs {
+		pbSubs = append(pbSubs, webhookSubscriptionToPb(sub))
+	}
+	return &pb.GetWebhookSubscriptionsResponse{
+		Subscriptions: pbSubs,
+	}, nil
+}
+
+// GetWebhookDeadLetters returns the webhook deliveries which failed all the attempts, from the newest one.
+func (s *adminService) GetWebhookDeadLetters(ctx context.Context, req *pb.GetWebhookDeadLettersRequest) (*pb.GetWebhookDeadLettersResponse, error) {
+	if err := s.authorizeWebhooks(ctx); err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	deadLetters, err := s.webhooks.GetDeadLetters(ctx, req.SubscriptionId, uint(limit), uint(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+	var pbDeadLetters []*pb.WebhookDeadLetter
+	for _, deadLetter := range deadLetters {
+		pbDeadLetters = append(pbDeadLetters, &pb.WebhookDeadLetter{
+			Id:             deadLetter.ID,
+			SubscriptionId: deadLetter.SubscriptionID,
+			EventType:      pb.DepositEventType(deadLetter.EventType),
+			Payload:        string(deadLetter.Payload),
+			Attempts:       uint32(deadLetter.Attempts),
+			LastError:      deadLetter.LastError,
+			CreatedAt:      deadLetter.CreatedAt.Unix(),
+			FailedAt:       deadLetter.FailedAt.Unix(),
+		})
+	}
+	return &pb.GetWebhookDeadLettersResponse{
+		DeadLetters: pbDeadLetters,
+	}, nil
+}
+
+// ReplayWebhookDeadLetters sends again the webhook deliveries which failed all the attempts.
+func (s *adminService) ReplayWebhookDeadLetters(ctx context.Context, req *pb.ReplayWebhookDeadLettersRequest) (*pb.ReplayWebhookDeadLettersResponse, error) {
+	if err := s.authorizeWebhooks(ctx); err != nil {
+		return nil, err
+	}
+	replayed, err := s.webhooks.ReplayDeadLetters(ctx, req.SubscriptionId, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReplayWebhookDeadLettersResponse{
+		Replayed: replayed,
+	}, nil
+}
+
+// authorizeWebhooks checks the bearer token of the request and that the webhooks are enabled.
+func (s *adminService) authorizeWebhooks(ctx context.Context) error {
+	if err := s.authorize(ctx); err != nil {
+		return err
+	}
+	if s.webhooks == nil {
+		return status.Error(codes.Unimplemented, "the webhooks are disabled")
+	}
+	return nil
+}
+
+func webhookSubscriptionToPb(sub *etherman.WebhookSubscription) *pb.WebhookSubscription {
+	pbSub := &pb.WebhookSubscription{
+		Id:        sub.ID,
+		Url:       sub.URL,
+		Address:   sub.Address.Hex(),
+		CreatedAt: sub.CreatedAt.Unix(),
+	}
+	if sub.NetworkID != nil {
+		networkID := uint32(*sub.NetworkID)
+		pbSub.NetId = &networkID
+	}
+	for _, eventType := range sub.EventTypes {
+		pbSub.EventTypes = append(pbSub.EventTypes, pb.DepositEventType(eventType))
+	}
+	return pbSub
+}
+
+func networkToPb(network *etherman.Network) *pb.Network {
+	return &pb.Network{
+		NetworkId:  uint32(network.NetworkID),
+		RpcUrl:     network.RPCURL,
+		BridgeAddr: network.BridgeAddr.Hex(),
+		StartBlock: network.StartBlock,
+		TreeIndex:  uint32(network.TreeIndex),
+		Active:     network.Active,
+	}
+}
@@ -6,4 +6,7 @@ type Config struct {
 	GRPCPort string
 	// HTTPPort is TCP port to listen by HTTP/REST gateway
 	HTTPPort string
+	// AdminToken is the bearer token required by the admin API, which registers networks at runtime.
+	// The admin API is disabled if it is empty.
+	AdminToken string
 }
@@ -28,5 +28,5 @@ func RunMockServer() (*bridgectrl.BridgeController, error) {
 		HTTPPort: "8080",
 	}
 
-	return bt, RunServer(store, bt, cfg)
+	return bt, RunServer(store, bt, nil, nil, nil, cfg)
 }
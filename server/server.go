@@ -20,8 +20,10 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-// RunServer runs gRPC server and HTTP gateway
-func RunServer(storage interface{}, bridgeCtrl *bridgectrl.BridgeController, cfg Config) error {
+// RunServer runs gRPC server and HTTP gateway. The admin service is served if the network manager and the admin
+// token are provided, and the deposit subscriptions if the notifier is provided. The admin webhook methods are
+// served if the webhooks are provided.
+func RunServer(storage interface{}, bridgeCtrl *bridgectrl.BridgeController, notifier *bridgectrl.DepositNotifier, networks NetworkManager, webhooks Webhooks, cfg Config) error {
 	ctx := context.Background()
 
 	if len(cfg.GRPCPort) == 0 {
@@ -32,14 +34,18 @@ func RunServer(storage interface{}, bridgeCtrl *bridgectrl.BridgeController, cfg
 		return fmt.Errorf("invalid TCP port for HTTP gateway: '%s'", cfg.HTTPPort)
 	}
 
-	bridgeService := bridgectrl.NewBridgeService(storage.(bridgectrl.BridgeServiceStorage), bridgeCtrl)
+	bridgeService := bridgectrl.NewBridgeService(storage.(bridgectrl.BridgeServiceStorage), bridgeCtrl, notifier)
+	var adminService pb.AdminServiceServer
+	if networks != nil && cfg.AdminToken != "" {
+		adminService = newAdminService(networks, bridgeCtrl, webhooks, cfg.AdminToken)
+	}
 
 	go func() {
-		_ = runRestServer(ctx, cfg.GRPCPort, cfg.HTTPPort)
+		_ = runRestServer(ctx, cfg.GRPCPort, cfg.HTTPPort, adminService != nil)
 	}()
 
 	go func() {
-		_ = runGRPCServer(ctx, bridgeService, cfg.GRPCPort)
+		_ = runGRPCServer(ctx, bridgeService, adminService, cfg.GRPCPort)
 	}()
 
 	return nil
@@ -72,7 +78,7 @@ func (s *healthChecker) Watch(req *grpc_health_v1.HealthCheckRequest, server grp
 	})
 }
 
-func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, port string) error {
+func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, adminServer pb.AdminServiceServer, port string) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
@@ -80,6 +86,9 @@ func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, por
 
 	server := grpc.NewServer()
 	pb.RegisterBridgeServiceServer(server, bridgeServer)
+	if adminServer != nil {
+		pb.RegisterAdminServiceServer(server, adminServer)
+	}
 
 	healthService := newHealthChecker()
 	grpc_health_v1.RegisterHealthServer(server, healthService)
@@ -98,7 +107,7 @@ func runGRPCServer(ctx context.Context, bridgeServer pb.BridgeServiceServer, por
 }
 
 func preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
+	headers := []string{"Content-Type", "Accept", "Authorization"}
 	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
 	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
 	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
@@ -119,7 +128,7 @@ func allowCORS(h http.Handler) http.Handler {
 	})
 }
 
-func runRestServer(ctx context.Context, grpcPort, httpPort string) error {
+func runRestServer(ctx context.Context, grpcPort, httpPort string, admin bool) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -145,10 +154,15 @@ func runRestServer(ctx context.Context, grpcPort, httpPort string) error {
 	if err := pb.RegisterBridgeServiceHandler(ctx, mux, conn); err != nil {
 		return err
 	}
+	if admin {
+		if err := pb.RegisterAdminServiceHandler(ctx, mux, conn); err != nil {
+			return err
+		}
+	}
 
 	srv := &http.Server{
 		Addr:    ":" + httpPort,
-		Handler: allowCORS(mux),
+		Handler: allowCORS(serverSentEvents(mux)),
 	}
 
 	c := make(chan os.Signal, 1)
@@ -6,8 +6,11 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl/pb"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/test/client"
 	"github.com/0xPolygonHermez/zkevm-bridge-service/test/operations"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -61,16 +64,38 @@ func TestBridgeMock(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, len(proof.MerkleProof), 32)
 
+	globalExitRoot := crypto.Keccak256Hash(common.HexToHash(proof.MainExitRoot).Bytes(), common.HexToHash(proof.RollupExitRoot).Bytes())
+	gerProof, err := restClient.GetMerkleProofByGlobalExitRoot(0, 2, globalExitRoot)
+	require.NoError(t, err)
+	require.Equal(t, proof.MerkleProof, gerProof.MerkleProof)
+	require.Equal(t, proof.MainExitRoot, gerProof.MainExitRoot)
+
+	verifyRes, err := restClient.VerifyProof(0, 2, proof.MerkleProof[:31], proof.MainExitRoot)
+	require.NoError(t, err)
+	require.False(t, verifyRes.Valid)
+	require.Equal(t, pb.ProofMismatchReason_PROOF_MISMATCH_REASON_INVALID_LENGTH, verifyRes.Reason)
+
+	verifyRes, err = restClient.VerifyProof(0, 2, proof.MerkleProof, proof.RollupExitRoot)
+	require.NoError(t, err)
+	require.False(t, verifyRes.Valid)
+	require.Equal(t, pb.ProofMismatchReason_PROOF_MISMATCH_REASON_ROOT_MISMATCH, verifyRes.Reason)
+	require.NotEmpty(t, verifyRes.ComputedRoot)
+
 	deposit, err := restClient.GetBridge(0, 2)
 	require.NoError(t, err)
 	require.NotEmpty(t, deposit.Metadata)
 	require.Equal(t, deposit.ReadyForClaim, true)
 	require.Equal(t, deposit.DepositCnt, uint64(2))
 
-	wrappedToken, err := restClient.GetWrappedToken(0, "0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D")
+	wrappedToken, err := restClient.GetWrappedToken(0, "0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D", 1000)
 	require.NoError(t, err)
 	require.Equal(t, wrappedToken.WrappedTokenAddr, "0xc2716D3537EcA4B318e60f3d7d6a48714f1F3335")
 	require.Equal(t, wrappedToken.Name, "CoinA")
 	require.Equal(t, wrappedToken.Symbol, "COA")
 	require.Equal(t, wrappedToken.Decimals, uint32(12))
+
+	wrappedToken, err = restClient.GetWrappedTokenByAddress("0xc2716D3537EcA4B318e60f3d7d6a48714f1F3335", 1000)
+	require.NoError(t, err)
+	require.Equal(t, wrappedToken.OriginalTokenAddr, "0x617b3a3528F9cDd6630fd3301B9c8911F7Bf063D")
+	require.Equal(t, wrappedToken.OrigNet, uint32(0))
 }
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+)
+
+const eventStreamContentType = "text/event-stream"
+
+// serverSentEvents serves the streaming methods of the gateway as server-sent events to the clients which accept
+// them. The gateway writes each message of the stream as a line of JSON, which is sent as the data of an event.
+func serverSentEvents(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept"), eventStreamContentType) {
+			h.ServeHTTP(w, r)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			h.ServeHTTP(w, r)
+			return
+		}
+		// The gateway only accepts its own content types
+		r.Header.Del("Accept")
+		h.ServeHTTP(&sseWriter{ResponseWriter: w, flusher: flusher}, r)
+	})
+}
+
+// sseWriter writes the lines written by the gateway as server-sent events.
+type sseWriter struct {
+	http.ResponseWriter
+	flusher http.Flusher
+	buf     []byte
+}
+
+func (w *sseWriter) WriteHeader(statusCode int) {
+	if statusCode == http.StatusOK {
+		w.Header().Set("Content-Type", eventStreamContentType)
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *sseWriter) Write(data []byte) (int, error) {
+	w.buf = append(w.buf, data...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			return len(data), nil
+		}
+		line := w.buf[:i]
+		w.buf = w.buf[i+1:]
+		if len(line) == 0 {
+			continue
+		}
+		if _, err := w.ResponseWriter.Write([]byte("data: " + string(line) + "\n\n")); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (w *sseWriter) Flush() {
+	w.flusher.Flush()
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerSentEvents(t *testing.T) {
+	h := serverSentEvents(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.Header.Get("Accept"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"result":1}` + "\n" + `{"res`))
+		_, _ = w.Write([]byte(`ult":2}` + "\n"))
+		w.(http.Flusher).Flush()
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/deposit-events", nil)
+	req.Header.Set("Accept", eventStreamContentType)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	require.Equal(t, eventStreamContentType, rec.Header().Get("Content-Type"))
+	require.Equal(t, "data: {\"result\":1}\n\ndata: {\"result\":2}\n\n", rec.Body.String())
+	require.True(t, rec.Flushed)
+}
@@ -3,6 +3,7 @@ package synchronizer
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	"github.com/ethereum/go-ethereum/common"
@@ -37,15 +38,40 @@ type storageInterface interface {
 	ResetTrustedState(ctx context.Context, batchNumber uint64, dbTx pgx.Tx) error
 	GetPreviousBlock(ctx context.Context, networkID uint, offset uint64, dbTx pgx.Tx) (*etherman.Block, error)
 	GetNumberDeposits(ctx context.Context, origNetworkID uint, blockNumber uint64, dbTx pgx.Tx) (uint64, error)
+	GetNetworkDeposits(ctx context.Context, networkID uint, fromDepositCnt uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error)
 	// GetNextForcedBatches returns the next forcedBatches in FIFO order
 	GetNextForcedBatches(ctx context.Context, nextForcedBatches int, dbTx pgx.Tx) ([]etherman.ForcedBatch, error)
 	AddBatchNumberInForcedBatch(ctx context.Context, forceBatchNumber, batchNumber uint64, dbTx pgx.Tx) error
 	AddForcedBatch(ctx context.Context, forcedBatch *etherman.ForcedBatch, dbTx pgx.Tx) error
 	AddTrustedGlobalExitRoot(ctx context.Context, trustedExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) error
 	GetLastVerifiedBatch(ctx context.Context, dbTx pgx.Tx) (*etherman.VerifiedBatch, error)
+	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
+	GetClaim(ctx context.Context, index uint, networkID uint, sourceNetworkID uint, dbTx pgx.Tx) (*etherman.Claim, error)
+	UpdateDepositStates(ctx context.Context, networkID uint, fromDepositCnt uint, toDepositCnt uint, state etherman.DepositState, blockID uint64, observedAt time.Time, dbTx pgx.Tx) error
+	GetFirstNotReadyDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error)
+	AddBlockStats(ctx context.Context, blockID uint64, dbTx pgx.Tx) error
 }
 
 type bridgectrlInterface interface {
-	AddDeposit(deposit *etherman.Deposit) error
-	ReorgMT(depositCount, networkID uint) error
+	AddDeposits(ctx context.Context, deposits []*etherman.Deposit, dbTx pgx.Tx) error
+	AddVerifiedExitRoot(ctx context.Context, localExitRoot common.Hash, blockID uint64, dbTx pgx.Tx) error
+	ReorgMT(ctx context.Context, depositCount, networkID uint, dbTx pgx.Tx) error
+	CommitMT(networkID uint, dbTx pgx.Tx) error
+	ClaimSourceNetwork(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (uint, error)
+	ExitRootDepositCounts(ctx context.Context, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) (map[uint]uint, error)
+}
+
+// networkStorage contains the methods required to persist the networks registered at runtime.
+type networkStorage interface {
+	AddNetwork(ctx context.Context, network *etherman.Network, dbTx pgx.Tx) error
+	GetNetworks(ctx context.Context, dbTx pgx.Tx) ([]*etherman.Network, error)
+	DeactivateNetwork(ctx context.Context, networkID uint, dbTx pgx.Tx) error
+}
+
+// networkBridgectrl contains the methods required to add and remove the exit trees of the networks registered at runtime.
+type networkBridgectrl interface {
+	bridgectrlInterface
+	RegisterNetwork(ctx context.Context, networkID uint, tID uint8) error
+	DeregisterNetwork(ctx context.Context, networkID uint) error
 }
@@ -0,0 +1,40 @@
+package synchronizer
+
+import (
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+)
+
+// Listener is notified of the changes stored by the synchronizers. The methods are called once the db transaction
+// of the changes is committed, from the goroutine of the synchronizer of the network, so they must not block.
+type Listener interface {
+	// BlockSynced is called when a block of the network is stored with its deposits, claims and global exit roots
+	BlockSynced(networkID uint, deposits []*etherman.Deposit, claims []*etherman.Claim, globalExitRoots []*etherman.GlobalExitRoot)
+	// TrustedExitRootSynced is called when the trusted global exit root of the main network is stored
+	TrustedExitRootSynced(globalExitRoot *etherman.GlobalExitRoot)
+	// Reorged is called when the blocks of the network are removed by a reorg, with the removed deposits
+	Reorged(networkID uint, removed []*etherman.Deposit)
+}
+
+// Listeners notifies several listeners of the changes, in order.
+type Listeners []Listener
+
+// BlockSynced notifies the listeners of a stored block.
+func (l Listeners) BlockSynced(networkID uint, deposits []*etherman.Deposit, claims []*etherman.Claim, globalExitRoots []*etherman.GlobalExitRoot) {
+	for _, listener := range l {
+		listener.BlockSynced(networkID, deposits, claims, globalExitRoots)
+	}
+}
+
+// TrustedExitRootSynced notifies the listeners of a stored trusted global exit root.
+func (l Listeners) TrustedExitRootSynced(globalExitRoot *etherman.GlobalExitRoot) {
+	for _, listener := range l {
+		listener.TrustedExitRootSynced(globalExitRoot)
+	}
+}
+
+// Reorged notifies the listeners of a reorg.
+func (l Listeners) Reorged(networkID uint, removed []*etherman.Deposit) {
+	for _, listener := range l {
+		listener.Reorged(networkID, removed)
+	}
+}
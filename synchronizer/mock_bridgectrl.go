@@ -3,8 +3,13 @@
 package synchronizer
 
 import (
+	context "context"
+
 	etherman "github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	common "github.com/ethereum/go-ethereum/common"
 	mock "github.com/stretchr/testify/mock"
+
+	pgx "github.com/jackc/pgx/v4"
 )
 
 // bridgectrlMock is an autogenerated mock type for the bridgectrlInterface type
@@ -12,13 +17,62 @@ type bridgectrlMock struct {
 	mock.Mock
 }
 
-// AddDeposit provides a mock function with given fields: deposit
-func (_m *bridgectrlMock) AddDeposit(deposit *etherman.Deposit) error {
-	ret := _m.Called(deposit)
+// AddDeposits provides a mock function with given fields: ctx, deposits, dbTx
+func (_m *bridgectrlMock) AddDeposits(ctx context.Context, deposits []*etherman.Deposit, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, deposits, dbTx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*etherman.Deposit, pgx.Tx) error); ok {
+		r0 = rf(ctx, deposits, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddVerifiedExitRoot provides a mock function with given fields: ctx, localExitRoot, blockID, dbTx
+func (_m *bridgectrlMock) AddVerifiedExitRoot(ctx context.Context, localExitRoot common.Hash, blockID uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, localExitRoot, blockID, dbTx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, localExitRoot, blockID, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClaimSourceNetwork provides a mock function with given fields: ctx, claim, dbTx
+func (_m *bridgectrlMock) ClaimSourceNetwork(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) (uint, error) {
+	ret := _m.Called(ctx, claim, dbTx)
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.Claim, pgx.Tx) uint); ok {
+		r0 = rf(ctx, claim, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *etherman.Claim, pgx.Tx) error); ok {
+		r1 = rf(ctx, claim, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CommitMT provides a mock function with given fields: networkID, dbTx
+func (_m *bridgectrlMock) CommitMT(networkID uint, dbTx pgx.Tx) error {
+	ret := _m.Called(networkID, dbTx)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*etherman.Deposit) error); ok {
-		r0 = rf(deposit)
+	if rf, ok := ret.Get(0).(func(uint, pgx.Tx) error); ok {
+		r0 = rf(networkID, dbTx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -26,13 +80,36 @@ func (_m *bridgectrlMock) AddDeposit(deposit *etherman.Deposit) error {
 	return r0
 }
 
-// ReorgMT provides a mock function with given fields: depositCount, networkID
-func (_m *bridgectrlMock) ReorgMT(depositCount uint, networkID uint) error {
-	ret := _m.Called(depositCount, networkID)
+// ExitRootDepositCounts provides a mock function with given fields: ctx, globalExitRoot, dbTx
+func (_m *bridgectrlMock) ExitRootDepositCounts(ctx context.Context, globalExitRoot *etherman.GlobalExitRoot, dbTx pgx.Tx) (map[uint]uint, error) {
+	ret := _m.Called(ctx, globalExitRoot, dbTx)
+
+	var r0 map[uint]uint
+	if rf, ok := ret.Get(0).(func(context.Context, *etherman.GlobalExitRoot, pgx.Tx) map[uint]uint); ok {
+		r0 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]uint)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *etherman.GlobalExitRoot, pgx.Tx) error); ok {
+		r1 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReorgMT provides a mock function with given fields: ctx, depositCount, networkID, dbTx
+func (_m *bridgectrlMock) ReorgMT(ctx context.Context, depositCount uint, networkID uint, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, depositCount, networkID, dbTx)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
-		r0 = rf(depositCount, networkID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, pgx.Tx) error); ok {
+		r0 = rf(ctx, depositCount, networkID, dbTx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -4,6 +4,7 @@ package synchronizer
 
 import (
 	context "context"
+	time "time"
 
 	etherman "github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
 	mock "github.com/stretchr/testify/mock"
@@ -65,6 +66,20 @@ func (_m *storageMock) AddBlock(ctx context.Context, block *etherman.Block, dbTx
 	return r0, r1
 }
 
+// AddBlockStats provides a mock function with given fields: ctx, blockID, dbTx
+func (_m *storageMock) AddBlockStats(ctx context.Context, blockID uint64, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, blockID, dbTx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, pgx.Tx) error); ok {
+		r0 = rf(ctx, blockID, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddClaim provides a mock function with given fields: ctx, claim, dbTx
 func (_m *storageMock) AddClaim(ctx context.Context, claim *etherman.Claim, dbTx pgx.Tx) error {
 	ret := _m.Called(ctx, claim, dbTx)
@@ -223,6 +238,50 @@ func (_m *storageMock) GetBatchByNumber(ctx context.Context, batchNumber uint64,
 	return r0, r1
 }
 
+// GetClaim provides a mock function with given fields: ctx, index, networkID, sourceNetworkID, dbTx
+func (_m *storageMock) GetClaim(ctx context.Context, index uint, networkID uint, sourceNetworkID uint, dbTx pgx.Tx) (*etherman.Claim, error) {
+	ret := _m.Called(ctx, index, networkID, sourceNetworkID, dbTx)
+
+	var r0 *etherman.Claim
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, uint, pgx.Tx) *etherman.Claim); ok {
+		r0 = rf(ctx, index, networkID, sourceNetworkID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.Claim)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, index, networkID, sourceNetworkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFirstNotReadyDepositCount provides a mock function with given fields: ctx, networkID, dbTx
+func (_m *storageMock) GetFirstNotReadyDepositCount(ctx context.Context, networkID uint, dbTx pgx.Tx) (uint, error) {
+	ret := _m.Called(ctx, networkID, dbTx)
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func(context.Context, uint, pgx.Tx) uint); ok {
+		r0 = rf(ctx, networkID, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetLastBatchNumber provides a mock function with given fields: ctx, dbTx
 func (_m *storageMock) GetLastBatchNumber(ctx context.Context, dbTx pgx.Tx) (uint64, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -290,6 +349,75 @@ func (_m *storageMock) GetLastVerifiedBatch(ctx context.Context, dbTx pgx.Tx) (*
 	return r0, r1
 }
 
+// GetLatestL1SyncedExitRoot provides a mock function with given fields: ctx, dbTx
+func (_m *storageMock) GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	var r0 *etherman.GlobalExitRoot
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *etherman.GlobalExitRoot); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.GlobalExitRoot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestTrustedExitRoot provides a mock function with given fields: ctx, dbTx
+func (_m *storageMock) GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	var r0 *etherman.GlobalExitRoot
+	if rf, ok := ret.Get(0).(func(context.Context, pgx.Tx) *etherman.GlobalExitRoot); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.GlobalExitRoot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pgx.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNetworkDeposits provides a mock function with given fields: ctx, networkID, fromDepositCnt, limit, dbTx
+func (_m *storageMock) GetNetworkDeposits(ctx context.Context, networkID uint, fromDepositCnt uint, limit uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	ret := _m.Called(ctx, networkID, fromDepositCnt, limit, dbTx)
+
+	var r0 []*etherman.Deposit
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, uint, pgx.Tx) []*etherman.Deposit); ok {
+		r0 = rf(ctx, networkID, fromDepositCnt, limit, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*etherman.Deposit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint, uint, uint, pgx.Tx) error); ok {
+		r1 = rf(ctx, networkID, fromDepositCnt, limit, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNextForcedBatches provides a mock function with given fields: ctx, nextForcedBatches, dbTx
 func (_m *storageMock) GetNextForcedBatches(ctx context.Context, nextForcedBatches int, dbTx pgx.Tx) ([]etherman.ForcedBatch, error) {
 	ret := _m.Called(ctx, nextForcedBatches, dbTx)
@@ -399,6 +527,20 @@ func (_m *storageMock) Rollback(ctx context.Context, dbTx pgx.Tx) error {
 	return r0
 }
 
+// UpdateDepositStates provides a mock function with given fields: ctx, networkID, fromDepositCnt, toDepositCnt, state, blockID, observedAt, dbTx
+func (_m *storageMock) UpdateDepositStates(ctx context.Context, networkID uint, fromDepositCnt uint, toDepositCnt uint, state etherman.DepositState, blockID uint64, observedAt time.Time, dbTx pgx.Tx) error {
+	ret := _m.Called(ctx, networkID, fromDepositCnt, toDepositCnt, state, blockID, observedAt, dbTx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint, uint, uint, etherman.DepositState, uint64, time.Time, pgx.Tx) error); ok {
+		r0 = rf(ctx, networkID, fromDepositCnt, toDepositCnt, state, blockID, observedAt, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTnewStorageMock interface {
 	mock.TestingT
 	Cleanup(func())
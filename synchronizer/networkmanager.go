@@ -0,0 +1,273 @@
+package synchronizer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/0xPolygonHermez/zkevm-node/sequencer/broadcast/pb"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NetworkManager runs the synchronizers of the networks. The networks of the config file are synced from the
+// startup, and L2 networks can be registered and deregistered at runtime.
+type NetworkManager struct {
+	storage         interface{}
+	networkStorage  networkStorage
+	bridgeCtrl      networkBridgectrl
+	broadcastClient pb.BroadcastServiceClient
+	listener        Listener
+	cfg             Config
+	// configTrees is the number of networks of the config file, their exit trees use the first indexes
+	configTrees     uint8
+	newL2Etherman   func(url string, bridgeAddr common.Address) (ethermanInterface, error)
+	newSynchronizer func(ethMan ethermanInterface, genBlockNumber uint64) (Synchronizer, error)
+
+	lock          sync.Mutex
+	synchronizers map[uint]*runningSynchronizer
+}
+
+type runningSynchronizer struct {
+	synchronizer Synchronizer
+	done         chan struct{}
+	// registered is true for the networks registered at runtime
+	registered bool
+	// stopping is true once the network is being deregistered
+	stopping bool
+}
+
+// NewNetworkManager creates a new NetworkManager. configNetworks is the number of networks of the config file.
+// The listener, which is optional, is notified by the synchronizers of all the networks.
+func NewNetworkManager(storage interface{}, bridge networkBridgectrl, broadcastClient pb.BroadcastServiceClient, listener Listener, configNetworks int, cfg Config) *NetworkManager {
+	m := &NetworkManager{
+		storage:         storage,
+		networkStorage:  storage.(networkStorage),
+		bridgeCtrl:      bridge,
+		broadcastClient: broadcastClient,
+		listener:        listener,
+		cfg:             cfg,
+		configTrees:     uint8(configNetworks),
+		newL2Etherman: func(url string, bridgeAddr common.Address) (ethermanInterface, error) {
+			return etherman.NewL2Client(url, bridgeAddr)
+		},
+		synchronizers: make(map[uint]*runningSynchronizer),
+	}
+	m.newSynchronizer = func(ethMan ethermanInterface, genBlockNumber uint64) (Synchronizer, error) {
+		return NewSynchronizer(m.storage, m.bridgeCtrl, ethMan, m.broadcastClient, m.listener, genBlockNumber, m.cfg)
+	}
+	return m
+}
+
+// Run starts the synchronizer of a network of the config file.
+func (m *NetworkManager) Run(ctx context.Context, ethMan ethermanInterface, genBlockNumber uint64) error {
+	networkID, err := ethMan.GetNetworkID(ctx)
+	if err != nil {
+		return err
+	}
+	sy, err := m.newSynchronizer(ethMan, genBlockNumber)
+	if err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.start(networkID, sy, false)
+	return nil
+}
+
+// LoadNetworks starts the synchronizers of the networks registered at runtime which weren't deregistered. The
+// networks which can't be started are skipped, so they don't stop the other networks, and are retried on the next
+// startup.
+func (m *NetworkManager) LoadNetworks(ctx context.Context) error {
+	networks, err := m.networkStorage.GetNetworks(ctx, nil)
+	if err != nil {
+		return err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, network := range networks {
+		if !network.Active {
+			continue
+		}
+		if network.TreeIndex < m.configTrees {
+			return fmt.Errorf("networkID: %d, the exit tree %d of the registered network is used by a network of the config file",
+				network.NetworkID, network.TreeIndex)
+		}
+		if err = m.load(ctx, network); err != nil {
+			log.Errorf("networkID: %d, error loading the registered network. Error: %s", network.NetworkID, err.Error())
+			continue
+		}
+		log.Infof("networkID: %d, registered network loaded", network.NetworkID)
+	}
+	return nil
+}
+
+// load starts the synchronizer of a registered network. The caller must hold the lock.
+func (m *NetworkManager) load(ctx context.Context, network *etherman.Network) error {
+	ethMan, err := m.newL2Etherman(network.RPCURL, network.BridgeAddr)
+	if err != nil {
+		return fmt.Errorf("error connecting to the network. Error: %w", err)
+	}
+	sy, err := m.newSynchronizer(ethMan, network.StartBlock)
+	if err != nil {
+		return err
+	}
+	err = m.bridgeCtrl.RegisterNetwork(ctx, network.NetworkID, network.TreeIndex)
+	if err != nil {
+		return fmt.Errorf("error registering the exit tree. Error: %w", err)
+	}
+	m.start(network.NetworkID, sy, true)
+	return nil
+}
+
+// RegisterNetwork registers the L2 network of the rpc url, creates its exit tree and starts syncing it from the
+// start block. A network deregistered before keeps its exit tree and continues from the last synced block.
+func (m *NetworkManager) RegisterNetwork(ctx context.Context, rpcURL string, bridgeAddr common.Address, startBlock uint64) (*etherman.Network, error) {
+	ethMan, err := m.newL2Etherman(rpcURL, bridgeAddr)
+	if err != nil {
+		return nil, err
+	}
+	networkID, err := ethMan.GetNetworkID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The synchronizer is created before anything is stored, so nothing is left behind if it fails
+	sy, err := m.newSynchronizer(ethMan, startBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if _, found := m.synchronizers[networkID]; found {
+		return nil, gerror.ErrNetworkAlreadyRegistered
+	}
+	networks, err := m.networkStorage.GetNetworks(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	network := &etherman.Network{
+		NetworkID:  networkID,
+		RPCURL:     rpcURL,
+		BridgeAddr: bridgeAddr,
+		StartBlock: startBlock,
+		Active:     true,
+	}
+	var reused bool
+	// The exit trees of the deregistered networks aren't reused, a new network takes the index after the last one
+	nextTreeIndex := uint(m.configTrees)
+	for _, n := range networks {
+		if n.NetworkID == networkID {
+			network.TreeIndex, reused = n.TreeIndex, true
+			break
+		}
+		if uint(n.TreeIndex) >= nextTreeIndex {
+			nextTreeIndex = uint(n.TreeIndex) + 1
+		}
+	}
+	if !reused {
+		if nextTreeIndex > math.MaxUint8 {
+			return nil, gerror.ErrExitTreesExhausted
+		}
+		network.TreeIndex = uint8(nextTreeIndex)
+	}
+
+	err = m.bridgeCtrl.RegisterNetwork(ctx, networkID, network.TreeIndex)
+	if err != nil {
+		return nil, err
+	}
+	err = m.networkStorage.AddNetwork(ctx, network, nil)
+	if err != nil {
+		if deregisterErr := m.bridgeCtrl.DeregisterNetwork(ctx, networkID); deregisterErr != nil {
+			log.Errorf("networkID: %d, error removing the exit tree. Error: %s", networkID, deregisterErr.Error())
+		}
+		return nil, err
+	}
+	m.start(networkID, sy, true)
+	log.Infof("networkID: %d, network registered. Tree index: %d", networkID, network.TreeIndex)
+	return network, nil
+}
+
+// DeregisterNetwork stops syncing a network registered at runtime and removes its exit tree. The synced data is
+// kept. The networks of the config file can't be deregistered.
+func (m *NetworkManager) DeregisterNetwork(ctx context.Context, networkID uint) error {
+	m.lock.Lock()
+	running, found := m.synchronizers[networkID]
+	if !found || running.stopping {
+		m.lock.Unlock()
+		return gerror.ErrNetworkNotRegister
+	}
+	if !running.registered {
+		m.lock.Unlock()
+		return gerror.ErrConfiguredNetwork
+	}
+	running.stopping = true
+	m.lock.Unlock()
+
+	// The lock isn't held while the synchronizer stops, so the other networks aren't blocked meanwhile
+	running.synchronizer.Stop()
+	<-running.done
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.synchronizers, networkID)
+	err := m.networkStorage.DeactivateNetwork(ctx, networkID, nil)
+	if err != nil {
+		return err
+	}
+	err = m.bridgeCtrl.DeregisterNetwork(ctx, networkID)
+	if err != nil {
+		return err
+	}
+	log.Infof("networkID: %d, network deregistered", networkID)
+	return nil
+}
+
+// GetNetworks returns the networks registered at runtime, including the deregistered ones.
+func (m *NetworkManager) GetNetworks(ctx context.Context) ([]*etherman.Network, error) {
+	return m.networkStorage.GetNetworks(ctx, nil)
+}
+
+// start runs the synchronizer of the network in a new goroutine. The caller must hold the lock.
+func (m *NetworkManager) start(networkID uint, sy Synchronizer, registered bool) {
+	running := &runningSynchronizer{
+		synchronizer: sy,
+		done:         make(chan struct{}),
+		registered:   registered,
+	}
+	m.synchronizers[networkID] = running
+	go func() {
+		err := sy.Sync()
+		close(running.done)
+		if err != nil {
+			m.failed(networkID, running, err)
+		}
+	}()
+}
+
+// failed handles the error of the synchronizer of a network. The service can't work without the networks of the
+// config file, but a network registered at runtime is deactivated, so it doesn't stop the other networks. It can be
+// registered again once fixed.
+func (m *NetworkManager) failed(networkID uint, running *runningSynchronizer, err error) {
+	if !running.registered {
+		log.Fatalf("networkID: %d, error syncing the network. Error: %s", networkID, err.Error())
+	}
+	log.Errorf("networkID: %d, error syncing the registered network, deactivating it. Error: %s", networkID, err.Error())
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	// The network may have been deregistered meanwhile, or is being deregistered
+	if m.synchronizers[networkID] != running || running.stopping {
+		return
+	}
+	delete(m.synchronizers, networkID)
+	ctx := context.Background()
+	if err := m.networkStorage.DeactivateNetwork(ctx, networkID, nil); err != nil {
+		log.Errorf("networkID: %d, error deactivating the network. Error: %s", networkID, err.Error())
+	}
+	if err := m.bridgeCtrl.DeregisterNetwork(ctx, networkID); err != nil {
+		log.Errorf("networkID: %d, error removing the exit tree. Error: %s", networkID, err.Error())
+	}
+}
@@ -0,0 +1,228 @@
+package synchronizer
+
+import (
+	context "context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/utils/gerror"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSynchronizer returns syncErr from Sync, or blocks until it's stopped when syncErr is nil.
+type fakeSynchronizer struct {
+	syncErr error
+	stop    chan struct{}
+}
+
+func newFakeSynchronizer(syncErr error) *fakeSynchronizer {
+	return &fakeSynchronizer{syncErr: syncErr, stop: make(chan struct{})}
+}
+
+func (s *fakeSynchronizer) Sync() error {
+	if s.syncErr != nil {
+		return s.syncErr
+	}
+	<-s.stop
+	return nil
+}
+
+func (s *fakeSynchronizer) Stop() {
+	close(s.stop)
+}
+
+func newTestNetworkManager(t *testing.T, networkID uint, sy Synchronizer, syErr error) (*NetworkManager, *networkStorageMock, *networkBridgectrlMock) {
+	storage := newNetworkStorageMock(t)
+	bridgeCtrl := newNetworkBridgectrlMock(t)
+	ethMan := newEthermanMock(t)
+	ethMan.On("GetNetworkID", mock.Anything).Return(networkID, nil).Maybe()
+
+	m := NewNetworkManager(storage, bridgeCtrl, nil, nil, 2, Config{})
+	m.newL2Etherman = func(url string, bridgeAddr common.Address) (ethermanInterface, error) {
+		return ethMan, nil
+	}
+	m.newSynchronizer = func(ethMan ethermanInterface, genBlockNumber uint64) (Synchronizer, error) {
+		return sy, syErr
+	}
+	return m, storage, bridgeCtrl
+}
+
+func TestRegisterNetwork(t *testing.T) {
+	ctx := context.Background()
+	bridgeAddr := common.HexToAddress("0x10")
+	var networkID uint = 5
+
+	t.Run("register and deregister", func(t *testing.T) {
+		m, storage, bridgeCtrl := newTestNetworkManager(t, networkID, newFakeSynchronizer(nil), nil)
+		storage.On("GetNetworks", ctx, nil).Return([]*etherman.Network{}, nil).Once()
+		bridgeCtrl.On("RegisterNetwork", ctx, networkID, uint8(2)).Return(nil).Once()
+		storage.On("AddNetwork", ctx, mock.Anything, nil).Return(nil).Once()
+
+		network, err := m.RegisterNetwork(ctx, "http://localhost:8123", bridgeAddr, 10)
+		require.NoError(t, err)
+		require.Equal(t, networkID, network.NetworkID)
+		require.Equal(t, uint8(2), network.TreeIndex)
+		_, err = m.RegisterNetwork(ctx, "http://localhost:8123", bridgeAddr, 10)
+		require.ErrorIs(t, err, gerror.ErrNetworkAlreadyRegistered)
+
+		storage.On("DeactivateNetwork", ctx, networkID, nil).Return(nil).Once()
+		bridgeCtrl.On("DeregisterNetwork", ctx, networkID).Return(nil).Once()
+		require.NoError(t, m.DeregisterNetwork(ctx, networkID))
+		require.ErrorIs(t, m.DeregisterNetwork(ctx, networkID), gerror.ErrNetworkNotRegister)
+	})
+
+	t.Run("no exit tree left", func(t *testing.T) {
+		m, storage, _ := newTestNetworkManager(t, networkID, newFakeSynchronizer(nil), nil)
+		storage.On("GetNetworks", ctx, nil).Return([]*etherman.Network{{NetworkID: 4, TreeIndex: 255}}, nil).Once()
+		_, err := m.RegisterNetwork(ctx, "http://localhost:8123", bridgeAddr, 10)
+		require.ErrorIs(t, err, gerror.ErrExitTreesExhausted)
+	})
+
+	t.Run("synchronizer creation fails", func(t *testing.T) {
+		// Nothing is stored, the mocks fail on unexpected calls
+		m, _, _ := newTestNetworkManager(t, networkID, nil, errors.New("connection refused"))
+		_, err := m.RegisterNetwork(ctx, "http://localhost:8123", bridgeAddr, 10)
+		require.Error(t, err)
+		require.ErrorIs(t, m.DeregisterNetwork(ctx, networkID), gerror.ErrNetworkNotRegister)
+	})
+
+	t.Run("sync fails", func(t *testing.T) {
+		m, storage, bridgeCtrl := newTestNetworkManager(t, networkID, newFakeSynchronizer(errors.New("sync error")), nil)
+		storage.On("GetNetworks", ctx, nil).Return([]*etherman.Network{}, nil).Once()
+		bridgeCtrl.On("RegisterNetwork", ctx, networkID, uint8(2)).Return(nil).Once()
+		storage.On("AddNetwork", ctx, mock.Anything, nil).Return(nil).Once()
+		deactivated := make(chan struct{})
+		storage.On("DeactivateNetwork", mock.Anything, networkID, nil).Return(nil).Once()
+		bridgeCtrl.On("DeregisterNetwork", mock.Anything, networkID).Run(func(args mock.Arguments) {
+			close(deactivated)
+		}).Return(nil).Once()
+
+		_, err := m.RegisterNetwork(ctx, "http://localhost:8123", bridgeAddr, 10)
+		require.NoError(t, err)
+		select {
+		case <-deactivated:
+		case <-time.After(time.Second):
+			t.Fatal("the network wasn't deactivated")
+		}
+		require.ErrorIs(t, m.DeregisterNetwork(ctx, networkID), gerror.ErrNetworkNotRegister)
+	})
+}
+
+func TestRegisteredNetworkSyncFails(t *testing.T) {
+	ctx := context.Background()
+	var networkID uint = 5
+	m, networkStorage, bridgeCtrl := newTestNetworkManager(t, networkID, nil, nil)
+	storage := newStorageMock(t)
+	dbTx := newDbTxMock(t)
+	ethMan := newEthermanMock(t)
+	// The real synchronizer is run, it fails storing the first synced block
+	m.newSynchronizer = func(_ ethermanInterface, genBlockNumber uint64) (Synchronizer, error) {
+		ethMan.On("GetNetworkID", mock.Anything).Return(networkID, nil).Once()
+		return NewSynchronizer(storage, bridgeCtrl, ethMan, nil, nil, genBlockNumber, Config{SyncChunkSize: 10})
+	}
+	storage.On("BeginDBTransaction", mock.Anything).Return(dbTx, nil)
+	storage.On("GetLastBlock", mock.Anything, networkID, dbTx).Return(nil, gerror.ErrStorageNotFound).Once()
+	storage.On("Commit", mock.Anything, dbTx).Return(nil).Once()
+	ethMan.On("EthBlockByNumber", mock.Anything, uint64(10)).Return(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)}), nil).Once()
+	ethMan.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(100)}, nil).Once()
+	toBlock := uint64(21)
+	blocks := []etherman.Block{{BlockNumber: 12, BlockHash: common.HexToHash("0x0c")}}
+	ethMan.On("GetRollupInfoByBlockRange", mock.Anything, uint64(11), &toBlock).Return(blocks, map[common.Hash][]etherman.Order{}, nil).Once()
+	storage.On("AddBlock", mock.Anything, mock.Anything, dbTx).Return(uint64(0), errors.New("db error")).Once()
+	storage.On("Rollback", mock.Anything, dbTx).Return(nil).Once()
+
+	networkStorage.On("GetNetworks", ctx, nil).Return([]*etherman.Network{}, nil).Once()
+	bridgeCtrl.On("RegisterNetwork", ctx, networkID, uint8(2)).Return(nil).Once()
+	networkStorage.On("AddNetwork", ctx, mock.Anything, nil).Return(nil).Once()
+	deactivated := make(chan struct{})
+	networkStorage.On("DeactivateNetwork", mock.Anything, networkID, nil).Return(nil).Once()
+	bridgeCtrl.On("DeregisterNetwork", mock.Anything, networkID).Run(func(args mock.Arguments) {
+		close(deactivated)
+	}).Return(nil).Once()
+
+	_, err := m.RegisterNetwork(ctx, "http://localhost:8123", common.HexToAddress("0x10"), 10)
+	require.NoError(t, err)
+	select {
+	case <-deactivated:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the network wasn't deactivated")
+	}
+	require.ErrorIs(t, m.DeregisterNetwork(ctx, networkID), gerror.ErrNetworkNotRegister)
+}
+
+func TestDeregisterSyncingNetwork(t *testing.T) {
+	ctx := context.Background()
+	var networkID uint = 5
+	m, networkStorage, bridgeCtrl := newTestNetworkManager(t, networkID, nil, nil)
+	storage := newStorageMock(t)
+	dbTx := newDbTxMock(t)
+	ethMan := newEthermanMock(t)
+	m.newSynchronizer = func(_ ethermanInterface, genBlockNumber uint64) (Synchronizer, error) {
+		ethMan.On("GetNetworkID", mock.Anything).Return(networkID, nil).Once()
+		return NewSynchronizer(storage, bridgeCtrl, ethMan, nil, nil, genBlockNumber, Config{SyncChunkSize: 10})
+	}
+	storage.On("BeginDBTransaction", mock.Anything).Return(dbTx, nil).Once()
+	storage.On("GetLastBlock", mock.Anything, networkID, dbTx).Return(nil, gerror.ErrStorageNotFound).Once()
+	storage.On("Commit", mock.Anything, dbTx).Return(nil).Once()
+	ethMan.On("EthBlockByNumber", mock.Anything, uint64(10)).Return(types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)}), nil).Once()
+	ethMan.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(1000000)}, nil)
+	// The network is catching up, the call returns when the context of the synchronizer is cancelled
+	syncing := make(chan struct{})
+	ethMan.On("GetRollupInfoByBlockRange", mock.Anything, uint64(11), mock.Anything).Run(func(args mock.Arguments) {
+		close(syncing)
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, nil, context.Canceled).Once()
+
+	networkStorage.On("GetNetworks", ctx, nil).Return([]*etherman.Network{}, nil).Once()
+	bridgeCtrl.On("RegisterNetwork", ctx, networkID, uint8(2)).Return(nil).Once()
+	networkStorage.On("AddNetwork", ctx, mock.Anything, nil).Return(nil).Once()
+	_, err := m.RegisterNetwork(ctx, "http://localhost:8123", common.HexToAddress("0x10"), 10)
+	require.NoError(t, err)
+	<-syncing
+
+	networkStorage.On("DeactivateNetwork", ctx, networkID, nil).Return(nil).Once()
+	bridgeCtrl.On("DeregisterNetwork", ctx, networkID).Return(nil).Once()
+	deregistered := make(chan error)
+	go func() { deregistered <- m.DeregisterNetwork(ctx, networkID) }()
+	select {
+	case err = <-deregistered:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the network wasn't deregistered")
+	}
+}
+
+func TestDeregisterConfigNetwork(t *testing.T) {
+	ctx := context.Background()
+	sy := newFakeSynchronizer(nil)
+	m, _, _ := newTestNetworkManager(t, 1, sy, nil)
+	ethMan := newEthermanMock(t)
+	ethMan.On("GetNetworkID", ctx).Return(uint(1), nil).Once()
+	require.NoError(t, m.Run(ctx, ethMan, 0))
+	require.ErrorIs(t, m.DeregisterNetwork(ctx, 1), gerror.ErrConfiguredNetwork)
+	sy.Stop()
+}
+
+func TestLoadNetworks(t *testing.T) {
+	ctx := context.Background()
+	sy := newFakeSynchronizer(nil)
+	m, storage, bridgeCtrl := newTestNetworkManager(t, 5, sy, nil)
+	networks := []*etherman.Network{
+		{NetworkID: 4, TreeIndex: 2, Active: false},
+		{NetworkID: 5, TreeIndex: 3, Active: true},
+	}
+	storage.On("GetNetworks", ctx, nil).Return(networks, nil).Once()
+	// The network which fails to register its tree is skipped
+	bridgeCtrl.On("RegisterNetwork", ctx, uint(5), uint8(3)).Return(errors.New("tree error")).Once()
+	require.NoError(t, m.LoadNetworks(ctx))
+	require.ErrorIs(t, m.DeregisterNetwork(ctx, 5), gerror.ErrNetworkNotRegister)
+
+	storage.On("GetNetworks", ctx, nil).Return([]*etherman.Network{{NetworkID: 5, TreeIndex: 1, Active: true}}, nil).Once()
+	require.Error(t, m.LoadNetworks(ctx))
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
@@ -30,25 +31,33 @@ type ClientSynchronizer struct {
 	storage         storageInterface
 	ctx             context.Context
 	cancelCtx       context.CancelFunc
+	stop            chan struct{}
+	stopOnce        sync.Once
 	genBlockNumber  uint64
 	cfg             Config
 	networkID       uint
 	broadcastClient pb.BroadcastServiceClient
+	listener        Listener
 	synced          bool
+	// trustedSynced is set once the trusted state is read, the deposits it makes claimable later are observed when
+	// they become claimable. The time of the ones made claimable while the service was stopped is unknown.
+	trustedSynced bool
 }
 
-// NewSynchronizer creates and initializes an instance of Synchronizer
+// NewSynchronizer creates and initializes an instance of Synchronizer. The listener is optional.
 func NewSynchronizer(
 	storage interface{},
 	bridge bridgectrlInterface,
 	ethMan ethermanInterface,
 	broadcastClient pb.BroadcastServiceClient,
+	listener Listener,
 	genBlockNumber uint64,
 	cfg Config) (Synchronizer, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	networkID, err := ethMan.GetNetworkID(ctx)
 	if err != nil {
-		log.Fatal("error getting networkID. Error: ", err)
+		cancel()
+		return nil, fmt.Errorf("error getting networkID. Error: %w", err)
 	}
 
 	if networkID == 0 {
@@ -58,10 +67,12 @@ func NewSynchronizer(
 			etherMan:        ethMan,
 			ctx:             ctx,
 			cancelCtx:       cancel,
+			stop:            make(chan struct{}),
 			genBlockNumber:  genBlockNumber,
 			cfg:             cfg,
 			networkID:       networkID,
 			broadcastClient: broadcastClient,
+			listener:        listener,
 		}, nil
 	}
 	return &ClientSynchronizer{
@@ -70,9 +81,11 @@ func NewSynchronizer(
 		etherMan:       ethMan,
 		ctx:            ctx,
 		cancelCtx:      cancel,
+		stop:           make(chan struct{}),
 		genBlockNumber: genBlockNumber,
 		cfg:            cfg,
 		networkID:      networkID,
+		listener:       listener,
 	}, nil
 }
 
@@ -84,46 +97,40 @@ func (s *ClientSynchronizer) Sync() error {
 	// If there is no lastEthereumBlock means that sync from the beginning is necessary. If not, it continues from the retrieved ethereum block
 	// Get the latest synced block. If there is no block on db, use genesis block
 	log.Infof("NetworkID: %d, Synchronization started", s.networkID)
-	dbTx, err := s.storage.BeginDBTransaction(s.ctx)
-	if err != nil {
-		log.Fatalf("networkID: %d, error creating db transaction to get latest block", s.networkID)
-	}
-	lastBlockSynced, err := s.storage.GetLastBlock(s.ctx, s.networkID, dbTx)
-	if err != nil {
-		if err == gerror.ErrStorageNotFound {
-			log.Warnf("networkID: %d, error getting the latest ethereum block. No data stored. Setting genesis block. Error: %w", s.networkID, err)
-			lastBlockSynced = &etherman.Block{
-				BlockNumber: s.genBlockNumber,
-				NetworkID:   s.networkID,
-			}
-			log.Warnf("networkID: %d, error getting the latest block. No data stored. Setting genesis block: %+v. Error: %s",
-				s.networkID, lastBlockSynced, err.Error())
-		} else {
-			log.Fatalf("networkID: %d, unexpected error getting the latest block. Error: %s", s.networkID, err.Error())
-		}
-	}
-	err = s.storage.Commit(s.ctx, dbTx)
+	defer s.cancelCtx()
+	lastBlockSynced, err := s.getLastBlockSynced()
 	if err != nil {
-		log.Errorf("networkID: %d, error committing dbTx, err: %s", s.networkID, err.Error())
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state. RollbackErr: %s, err: %s",
-				s.networkID, rollbackErr.Error(), err.Error())
+		if s.ctx.Err() != nil {
+			log.Info("synchronizer stopped. NetworkID: ", s.networkID)
+			return nil
 		}
-		log.Fatalf("networkID: %d, error committing dbTx, err: %s", s.networkID, err.Error())
+		return err
 	}
 	for {
+		// The stop request is checked first, the wait below is usually already elapsed
 		select {
-		case <-s.ctx.Done():
-			log.Debug("synchronizer ctx done. NetworkID: ", s.networkID)
+		case <-s.stop:
+			log.Info("synchronizer stopped. NetworkID: ", s.networkID)
+			return nil
+		default:
+		}
+		select {
+		case <-s.stop:
+			log.Info("synchronizer stopped. NetworkID: ", s.networkID)
 			return nil
 		case <-time.After(waitDuration):
 			//Sync L1Blocks
 			if lastBlockSynced, err = s.syncBlocks(lastBlockSynced); err != nil {
-				log.Warn("error syncing blocks: ", err)
+				// The context is only cancelled by a stop, the errors it causes aren't reported
 				if s.ctx.Err() != nil {
 					continue
 				}
+				// The blocks which can't be stored aren't retried, the network must be fixed first
+				var blockErr *blockError
+				if errors.As(err, &blockErr) {
+					return err
+				}
+				log.Warn("error syncing blocks: ", err)
 			}
 			if !s.synced {
 				// Check latest Block
@@ -138,7 +145,7 @@ func (s *ClientSynchronizer) Sync() error {
 					s.synced = true
 				}
 				if lastBlockSynced.BlockNumber > lastKnownBlock.Uint64() {
-					log.Fatalf("networkID: %d, error: latest Synced BlockNumber is higher than the latest Proposed in the network", s.networkID)
+					return fmt.Errorf("networkID: %d, error: latest Synced BlockNumber is higher than the latest Proposed in the network", s.networkID)
 				}
 			} else { // Sync Trusted GlobalExitRoots if L1 is synced
 				if s.networkID != 0 {
@@ -154,12 +161,70 @@ func (s *ClientSynchronizer) Sync() error {
 	}
 }
 
-// Stop function stops the synchronizer
+// getLastBlockSynced returns the latest block stored for the network, or the genesis block if there is none.
+func (s *ClientSynchronizer) getLastBlockSynced() (*etherman.Block, error) {
+	dbTx, err := s.storage.BeginDBTransaction(s.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("networkID: %d, error creating db transaction to get latest block. Error: %w", s.networkID, err)
+	}
+	lastBlockSynced, err := s.storage.GetLastBlock(s.ctx, s.networkID, dbTx)
+	if err != nil {
+		if err != gerror.ErrStorageNotFound {
+			s.rollback(dbTx, err)
+			return nil, fmt.Errorf("networkID: %d, unexpected error getting the latest block. Error: %w", s.networkID, err)
+		}
+		lastBlockSynced = &etherman.Block{
+			BlockNumber: s.genBlockNumber,
+			NetworkID:   s.networkID,
+		}
+		log.Warnf("networkID: %d, error getting the latest block. No data stored. Setting genesis block: %+v. Error: %s",
+			s.networkID, lastBlockSynced, err.Error())
+	}
+	err = s.storage.Commit(s.ctx, dbTx)
+	if err != nil {
+		s.rollback(dbTx, err)
+		return nil, fmt.Errorf("networkID: %d, error committing dbTx, err: %w", s.networkID, err)
+	}
+	return lastBlockSynced, nil
+}
+
+// rollback rolls back dbTx after the error err. The error of the rollback is only logged, as err is returned.
+func (s *ClientSynchronizer) rollback(dbTx pgx.Tx, err error) {
+	rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+	if rollbackErr != nil {
+		log.Errorf("networkID: %d, error rolling back state. RollbackErr: %s, err: %s", s.networkID, rollbackErr.Error(), err.Error())
+	}
+}
+
+// blockError is the error storing a synced block. Unlike the errors reading the network, it isn't retried.
+type blockError struct {
+	networkID   uint
+	blockNumber uint64
+	err         error
+}
+
+func (e *blockError) Error() string {
+	return fmt.Sprintf("networkID: %d, error storing block. BlockNumber: %d, error: %s", e.networkID, e.blockNumber, e.err.Error())
+}
+
+func (e *blockError) Unwrap() error {
+	return e.err
+}
+
+// Stop function stops the synchronizer. The context is cancelled, so the calls in progress return at once, and the
+// block being stored is rolled back, so the state stored for the network stays consistent.
 func (s *ClientSynchronizer) Stop() {
-	s.cancelCtx()
+	s.stopOnce.Do(func() {
+		close(s.stop)
+		s.cancelCtx()
+	})
 }
 
 func (s *ClientSynchronizer) syncTrustedState() error {
+	var observedAt time.Time
+	if s.trustedSynced {
+		observedAt = time.Now()
+	}
 	lastBatch, err := s.broadcastClient.GetLastBatch(s.ctx, &emptypb.Empty{})
 	if err != nil {
 		log.Errorf("networkID: %d, error getting latest batch from grpc. Error: %w", s.networkID, err)
@@ -172,14 +237,55 @@ func (s *ClientSynchronizer) syncTrustedState() error {
 			common.HexToHash(lastBatch.RollupExitRoot),
 		},
 	}
-	err = s.storage.AddTrustedGlobalExitRoot(s.ctx, ger, nil)
+	// The trusted exit root is stored with the states of the deposits it makes claimable, so they are never missed
+	dbTx, err := s.storage.BeginDBTransaction(s.ctx)
 	if err != nil {
-		log.Error("networkID: %d, error storing latest trusted globalExitRoot. Error: %w", s.networkID, err)
+		log.Errorf("networkID: %d, error creating db transaction to store the trusted state. Error: %s", s.networkID, err.Error())
+		return err
+	}
+	err = s.storage.AddTrustedGlobalExitRoot(s.ctx, ger, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error storing latest trusted globalExitRoot. Error: %s", s.networkID, err.Error())
+		s.rollback(dbTx, err)
+		return err
+	}
+	if err = s.updateTrustedDepositStates(ger, observedAt, dbTx); err != nil {
+		log.Errorf("networkID: %d, error storing the transitions of the trusted globalExitRoot. Error: %s", s.networkID, err.Error())
+		s.rollback(dbTx, err)
+		return err
+	}
+	if err = s.storage.Commit(s.ctx, dbTx); err != nil {
+		log.Errorf("networkID: %d, error committing the trusted state. Error: %s", s.networkID, err.Error())
+		s.rollback(dbTx, err)
 		return err
 	}
+	s.trustedSynced = true
+	if s.listener != nil {
+		s.listener.TrustedExitRootSynced(ger)
+	}
 	return nil
 }
 
+// updateTrustedDepositStates moves the mainnet deposits included in the trusted exit root to claimable, they are
+// claimed in the rollups with it. Only the deposits from the first one which isn't claimable yet are moved.
+func (s *ClientSynchronizer) updateTrustedDepositStates(ger *etherman.GlobalExitRoot, observedAt time.Time, dbTx pgx.Tx) error {
+	depositCnts, err := s.bridgeCtrl.ExitRootDepositCounts(s.ctx, ger, dbTx)
+	if err != nil {
+		return err
+	}
+	depositCnt, found := depositCnts[0]
+	if !found {
+		return nil
+	}
+	fromDepositCnt, err := s.storage.GetFirstNotReadyDepositCount(s.ctx, 0, dbTx)
+	if errors.Is(err, gerror.ErrStorageNotFound) || (err == nil && fromDepositCnt >= depositCnt) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return s.storage.UpdateDepositStates(s.ctx, 0, fromDepositCnt, depositCnt, etherman.DepositStateClaimable, 0, observedAt, dbTx)
+}
+
 // This function syncs the node from a specific block to the latest
 func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*etherman.Block, error) {
 	log.Debugf("NetworkID: %d, before checkReorg. lastBlockSynced: %+v", s.networkID, lastBlockSynced)
@@ -211,6 +317,12 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 	}
 
 	for {
+		// The catch up of a network can take long, the stop request is checked on every chunk
+		select {
+		case <-s.stop:
+			return lastBlockSynced, nil
+		default:
+		}
 		toBlock := fromBlock + s.cfg.SyncChunkSize
 
 		log.Debugf("NetworkID: %d, Getting bridge info from block %d to block %d", s.networkID, fromBlock, toBlock)
@@ -223,7 +335,9 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 		if err != nil {
 			return lastBlockSynced, err
 		}
-		s.processBlockRange(blocks, order)
+		if err = s.processBlockRange(blocks, order); err != nil {
+			return lastBlockSynced, err
+		}
 		if len(blocks) > 0 {
 			lastBlockSynced = &blocks[len(blocks)-1]
 			for i := range blocks {
@@ -249,7 +363,9 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 				ParentHash:  fb.ParentHash(),
 				ReceivedAt:  time.Unix(int64(fb.Time()), 0),
 			}
-			s.processBlockRange([]etherman.Block{b}, order)
+			if err = s.processBlockRange([]etherman.Block{b}, order); err != nil {
+				return lastBlockSynced, err
+			}
 
 			lastBlockSynced = &b
 			log.Debugf("NetworkID: %d, Storing empty block. BlockNumber: %d. BlockHash: %s",
@@ -260,60 +376,100 @@ func (s *ClientSynchronizer) syncBlocks(lastBlockSynced *etherman.Block) (*ether
 	return lastBlockSynced, nil
 }
 
-func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order map[common.Hash][]etherman.Order) {
+func (s *ClientSynchronizer) processBlockRange(blocks []etherman.Block, order map[common.Hash][]etherman.Order) error {
 	// New info has to be included into the db using the state
 	for i := range blocks {
 		// Begin db transaction
 		dbTx, err := s.storage.BeginDBTransaction(s.ctx)
 		if err != nil {
-			log.Fatalf("networkID: %d, error creating db transaction to store block. BlockNumber: %d",
-				s.networkID, blocks[i].BlockNumber)
+			return &blockError{networkID: s.networkID, blockNumber: blocks[i].BlockNumber, err: err}
+		}
+		deposits, claims, globalExitRoots, err := s.processBlock(&blocks[i], order[blocks[i].BlockHash], dbTx)
+		if err == nil {
+			err = s.storage.Commit(s.ctx, dbTx)
 		}
-		// Add block information
-		blocks[i].NetworkID = s.networkID
-		blockID, err := s.storage.AddBlock(s.ctx, &blocks[i], dbTx)
 		if err != nil {
-			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-			if rollbackErr != nil {
-				log.Fatalf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %s, err: %s",
-					s.networkID, blocks[i].BlockNumber, rollbackErr.Error(), err.Error())
-			}
-			log.Fatalf("networkID: %d, error storing block. BlockNumber: %d, error: %s",
-				s.networkID, blocks[i].BlockNumber, err.Error())
-		}
-		for _, element := range order[blocks[i].BlockHash] {
-			switch element.Name {
-			case etherman.SequenceBatchesOrder:
-				s.processSequenceBatches(blocks[i].SequencedBatches[element.Pos], blockID, blocks[i].BlockNumber, dbTx)
-			case etherman.ForcedBatchesOrder:
-				s.processForcedBatch(blocks[i].ForcedBatches[element.Pos], blockID, dbTx)
-			case etherman.GlobalExitRootsOrder:
-				s.processGlobalExitRoot(blocks[i].GlobalExitRoots[element.Pos], blockID, dbTx)
-			case etherman.SequenceForceBatchesOrder:
-				s.processSequenceForceBatches(blocks[i].SequencedForceBatches[element.Pos], blocks[i], dbTx)
-			case etherman.TrustedVerifyBatchOrder:
-				s.processTrustedVerifyBatch(blocks[i].VerifiedBatches[element.Pos], blockID, blocks[i].BlockNumber, dbTx)
-			case etherman.DepositsOrder:
-				s.processDeposit(blocks[i].Deposits[element.Pos], blockID, dbTx)
-			case etherman.ClaimsOrder:
-				s.processClaim(blocks[i].Claims[element.Pos], blockID, dbTx)
-			case etherman.TokensOrder:
-				s.processTokenWrapped(blocks[i].Tokens[element.Pos], blockID, dbTx)
+			s.rollback(dbTx, err)
+			return &blockError{networkID: s.networkID, blockNumber: blocks[i].BlockNumber, err: err}
+		}
+		if len(deposits) > 0 {
+			err = s.bridgeCtrl.CommitMT(s.networkID, dbTx)
+			if err != nil {
+				return &blockError{networkID: s.networkID, blockNumber: blocks[i].BlockNumber,
+					err: fmt.Errorf("error updating the bridge tree after committing block: %w", err)}
 			}
 		}
-		err = s.storage.Commit(s.ctx, dbTx)
+		if s.listener != nil {
+			s.listener.BlockSynced(s.networkID, deposits, claims, globalExitRoots)
+		}
+	}
+	return nil
+}
+
+// processBlock stores the block and its events inside dbTx. It returns the deposits, claims and global exit roots
+// synced in the block.
+func (s *ClientSynchronizer) processBlock(block *etherman.Block, order []etherman.Order, dbTx pgx.Tx) ([]*etherman.Deposit, []*etherman.Claim, []*etherman.GlobalExitRoot, error) {
+	// Add block information
+	block.NetworkID = s.networkID
+	blockID, err := s.storage.AddBlock(s.ctx, block, dbTx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var (
+		deposits        []*etherman.Deposit
+		claims          []*etherman.Claim
+		globalExitRoots []*etherman.GlobalExitRoot
+		batchVerified   bool
+	)
+	for _, element := range order {
+		switch element.Name {
+		case etherman.SequenceBatchesOrder:
+			err = s.processSequenceBatches(block.SequencedBatches[element.Pos], blockID, block.BlockNumber, dbTx)
+		case etherman.ForcedBatchesOrder:
+			err = s.processForcedBatch(block.ForcedBatches[element.Pos], blockID, dbTx)
+		case etherman.GlobalExitRootsOrder:
+			var globalExitRoot *etherman.GlobalExitRoot
+			globalExitRoot, err = s.processGlobalExitRoot(block.GlobalExitRoots[element.Pos], blockID, dbTx)
+			globalExitRoots = append(globalExitRoots, globalExitRoot)
+		case etherman.SequenceForceBatchesOrder:
+			err = s.processSequenceForceBatches(block.SequencedForceBatches[element.Pos], *block, dbTx)
+		case etherman.TrustedVerifyBatchOrder:
+			err = s.processTrustedVerifyBatch(block.VerifiedBatches[element.Pos], blockID, block.BlockNumber, dbTx)
+			batchVerified = true
+		case etherman.DepositsOrder:
+			var deposit *etherman.Deposit
+			deposit, err = s.processDeposit(block.Deposits[element.Pos], blockID, dbTx)
+			deposits = append(deposits, deposit)
+		case etherman.ClaimsOrder:
+			var claim *etherman.Claim
+			claim, err = s.processClaim(block.Claims[element.Pos], blockID, dbTx)
+			claims = append(claims, claim)
+		case etherman.TokensOrder:
+			err = s.processTokenWrapped(block.Tokens[element.Pos], blockID, dbTx)
+		}
 		if err != nil {
-			log.Errorf("networkID: %d, error committing state to store block. BlockNumber: %d, err: %s",
-				s.networkID, blocks[i].BlockNumber, err.Error())
-			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-			if rollbackErr != nil {
-				log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, err: %s",
-					s.networkID, blocks[i].BlockNumber, rollbackErr.Error(), err.Error())
-			}
-			log.Fatalf("networkID: %d, error committing state to store block. BlockNumber: %d, err: %s",
-				s.networkID, blocks[i].BlockNumber, err.Error())
+			return nil, nil, nil, err
+		}
+	}
+	if len(deposits) > 0 {
+		// All the deposits of the block are added to the bridge tree in one batch
+		err = s.bridgeCtrl.AddDeposits(s.ctx, deposits, dbTx)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to store new deposits in the bridge tree: %w", err)
+		}
+	}
+	if len(deposits) > 0 || len(claims) > 0 {
+		// The statistics are updated once with all the deposits and claims of the block
+		err = s.storage.AddBlockStats(s.ctx, blockID, dbTx)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to update the bridge statistics: %w", err)
 		}
 	}
+	err = s.updateDepositStates(deposits, globalExitRoots, batchVerified, blockID, dbTx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error storing the deposit transitions: %w", err)
+	}
+	return deposits, claims, globalExitRoots, nil
 }
 
 // This function allows reset the state until an specific ethereum block
@@ -324,7 +480,9 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 		log.Errorf("networkID: %d, Error starting a db transaction to reset the state. Error: %s", s.networkID, err.Error())
 		return err
 	}
-	err = s.storage.Reset(s.ctx, blockNumber, s.networkID, dbTx)
+	// The deposits kept by the reset are counted before it, so the removed deposits can be read for their transitions
+	// and the listener
+	depositCnt, err := s.storage.GetNumberDeposits(s.ctx, s.networkID, blockNumber, dbTx)
 	if err != nil {
 		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
 		if rollbackErr != nil {
@@ -332,10 +490,10 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 				s.networkID, blockNumber, rollbackErr.Error(), err.Error())
 			return rollbackErr
 		}
-		log.Errorf("networkID: %d, error resetting the state. Error: %s", s.networkID, err.Error())
+		log.Error("networkID: %d, error getting GetNumberDeposits. Error: %s", s.networkID, err.Error())
 		return err
 	}
-	depositCnt, err := s.storage.GetNumberDeposits(s.ctx, s.networkID, blockNumber, dbTx)
+	removed, err := s.getDepositsFrom(uint(depositCnt), dbTx)
 	if err != nil {
 		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
 		if rollbackErr != nil {
@@ -343,11 +501,34 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 				s.networkID, blockNumber, rollbackErr.Error(), err.Error())
 			return rollbackErr
 		}
-		log.Error("networkID: %d, error getting GetNumberDeposits. Error: %s", s.networkID, err.Error())
+		log.Errorf("networkID: %d, error getting the deposits removed by the reset. Error: %s", s.networkID, err.Error())
+		return err
+	}
+	// The transitions of the removed deposits are kept, so their history ends with the reorg
+	err = s.storage.UpdateDepositStates(s.ctx, s.networkID, uint(depositCnt), uint(depositCnt)+uint(len(removed)), etherman.DepositStateReorged, 0, time.Now(), dbTx)
+	if err != nil {
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %s, error : %s",
+				s.networkID, blockNumber, rollbackErr.Error(), err.Error())
+			return rollbackErr
+		}
+		log.Errorf("networkID: %d, error storing the transitions of the deposits removed by the reset. Error: %s", s.networkID, err.Error())
+		return err
+	}
+	err = s.storage.Reset(s.ctx, blockNumber, s.networkID, dbTx)
+	if err != nil {
+		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %s, error : %s",
+				s.networkID, blockNumber, rollbackErr.Error(), err.Error())
+			return rollbackErr
+		}
+		log.Errorf("networkID: %d, error resetting the state. Error: %s", s.networkID, err.Error())
 		return err
 	}
 
-	err = s.bridgeCtrl.ReorgMT(uint(depositCnt), s.networkID)
+	err = s.bridgeCtrl.ReorgMT(s.ctx, uint(depositCnt), s.networkID, dbTx)
 	if err != nil {
 		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
 		if rollbackErr != nil {
@@ -369,10 +550,34 @@ func (s *ClientSynchronizer) resetState(blockNumber uint64) error {
 		log.Errorf("networkID: %d, error committing the resetted state. Error: %s", s.networkID, err.Error())
 		return err
 	}
+	err = s.bridgeCtrl.CommitMT(s.networkID, dbTx)
+	if err != nil {
+		log.Errorf("networkID: %d, error updating the bridge tree after committing the resetted state. Error: %s", s.networkID, err.Error())
+		return err
+	}
+	if s.listener != nil {
+		s.listener.Reorged(s.networkID, removed)
+	}
 
 	return nil
 }
 
+// getDepositsFrom returns the deposits of the network from the deposit count.
+func (s *ClientSynchronizer) getDepositsFrom(depositCnt uint, dbTx pgx.Tx) ([]*etherman.Deposit, error) {
+	const pageSize = 1000
+	var deposits []*etherman.Deposit
+	for {
+		page, err := s.storage.GetNetworkDeposits(s.ctx, s.networkID, depositCnt+uint(len(deposits)), pageSize, dbTx)
+		if err != nil {
+			return nil, err
+		}
+		deposits = append(deposits, page...)
+		if len(page) < pageSize {
+			return deposits, nil
+		}
+	}
+}
+
 /*
 This function will check if there is a reorg.
 As input param needs the last ethereum block synced. Retrieve the block info from the blockchain
@@ -411,18 +616,13 @@ func (s *ClientSynchronizer) checkReorg(latestBlock *etherman.Block) (*etherman.
 			// Reorg detected. Getting previous block
 			dbTx, err := s.storage.BeginDBTransaction(s.ctx)
 			if err != nil {
-				log.Fatalf("networkID: %d, error creating db transaction to get previous blocks", s.networkID)
+				return nil, fmt.Errorf("networkID: %d, error creating db transaction to get previous blocks. Error: %w", s.networkID, err)
 			}
 			latestBlock, err = s.storage.GetPreviousBlock(s.ctx, s.networkID, depth, dbTx)
 			errC := s.storage.Commit(s.ctx, dbTx)
 			if errC != nil {
-				log.Errorf("networkID: %d, error committing dbTx, err: %s", s.networkID, errC.Error())
-				rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-				if rollbackErr != nil {
-					log.Fatalf("networkID: %d, error rolling back state. RollbackErr: %s, err: %s",
-						s.networkID, rollbackErr.Error(), errC.Error())
-				}
-				log.Fatalf("networkID: %d, error committing dbTx, err: %s", s.networkID, errC.Error())
+				s.rollback(dbTx, errC)
+				return nil, fmt.Errorf("networkID: %d, error committing dbTx, err: %w", s.networkID, errC)
 			}
 			if errors.Is(err, gerror.ErrStorageNotFound) {
 				log.Warnf("networkID: %d, error checking reorg: previous block not found in db: %s", s.networkID, err.Error())
@@ -458,7 +658,7 @@ func (s *ClientSynchronizer) checkTrustedState(batch etherman.Batch, dbTx pgx.Tx
 	return false, nil
 }
 
-func (s *ClientSynchronizer) processSequenceBatches(sequencedBatches []etherman.SequencedBatch, blockID, blockNumber uint64, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processSequenceBatches(sequencedBatches []etherman.SequencedBatch, blockID, blockNumber uint64, dbTx pgx.Tx) error {
 	for _, sbatch := range sequencedBatches {
 		batch := etherman.Batch{
 			BatchNumber:    sbatch.BatchNumber,
@@ -472,77 +672,38 @@ func (s *ClientSynchronizer) processSequenceBatches(sequencedBatches []etherman.
 			// Read forcedBatches from db
 			forcedBatches, err := s.storage.GetNextForcedBatches(s.ctx, 1, dbTx)
 			if err != nil {
-				log.Errorf("networkID: %d, error getting forcedBatches. BatchNumber: %d", s.networkID, batch.BatchNumber)
-				rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-				if rollbackErr != nil {
-					log.Fatalf("NetworkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error: %w",
-						s.networkID, batch.BatchNumber, blockNumber, rollbackErr.Error(), err)
-				}
-				log.Fatalf("networkID: %d, error getting forcedBatches. BatchNumber: %d, BlockNumber: %d, error: %w",
-					s.networkID, batch.BatchNumber, blockNumber, err)
+				return fmt.Errorf("error getting forcedBatches. BatchNumber: %d, error: %w", batch.BatchNumber, err)
 			}
 			if len(forcedBatches) == 0 {
-				log.Errorf("networkID: %d, error: empty forcedBatches array read from db. BatchNumber: %d", s.networkID, batch.BatchNumber)
-				rollbackErr := dbTx.Rollback(s.ctx)
-				if rollbackErr != nil {
-					log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error: %w", s.networkID, batch.BatchNumber, blockNumber, rollbackErr.Error(), err)
-				}
-				log.Fatal("networkID: %d, error: empty forcedBatches array read from db. BatchNumber: %d", s.networkID, batch.BatchNumber)
+				return fmt.Errorf("error: empty forcedBatches array read from db. BatchNumber: %d", batch.BatchNumber)
 			}
 			if uint64(forcedBatches[0].ForcedAt.Unix()) != sbatch.MinForcedTimestamp ||
 				forcedBatches[0].GlobalExitRoot != sbatch.GlobalExitRoot ||
 				common.Bytes2Hex(forcedBatches[0].RawTxsData) != common.Bytes2Hex(sbatch.Transactions) ||
 				forcedBatches[0].Sequencer != sbatch.Sequencer {
-				log.Errorf("networkID: %d, error: forcedBatch received doesn't match with the next expected forcedBatch stored in db. Expected: %+v, Synced: %+v", s.networkID, forcedBatches, sbatch)
-				rollbackErr := dbTx.Rollback(s.ctx)
-				if rollbackErr != nil {
-					log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %w", s.networkID, sbatch.BatchNumber, blockNumber, rollbackErr)
-				}
-				log.Fatalf("networkID: %d, error: forcedBatch received doesn't match with the next expected forcedBatch stored in db. Expected: %+v, Synced: %+v", s.networkID, forcedBatches, sbatch)
+				return fmt.Errorf("error: forcedBatch received doesn't match with the next expected forcedBatch stored in db. Expected: %+v, Synced: %+v", forcedBatches, sbatch)
 			}
 
 			// Store batchNumber in forced_batch table
 			err = s.storage.AddBatchNumberInForcedBatch(s.ctx, forcedBatches[0].ForcedBatchNumber, batch.BatchNumber, dbTx)
 			if err != nil {
-				log.Errorf("networkID: %d, error adding the batchNumber to forcedBatch in processSequenceBatches. BlockNumber: %d",
-					s.networkID, blockNumber)
-				rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-				if rollbackErr != nil {
-					log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, error : %w",
-						s.networkID, blockNumber, rollbackErr.Error(), err)
-				}
-				log.Fatalf("networkID: %d, error adding the batchNumber to forcedBatch in processSequenceBatches. BlockNumber: %d, error: %w",
-					s.networkID, blockNumber, err)
+				return fmt.Errorf("error adding the batchNumber to forcedBatch in processSequenceBatches. BatchNumber: %d, error: %w", batch.BatchNumber, err)
 			}
 		}
 
 		// Call the check trusted state method to compare trusted and virtual state
 		status, err := s.checkTrustedState(batch, dbTx)
 		if err != nil {
-			if errors.Is(err, gerror.ErrStorageNotFound) {
-				log.Debugf("NetworkID: %d, BatchNumber: %d, not found in trusted state. Storing it...", s.networkID, batch.BatchNumber)
-				// If it is not found, store batch
-				err = s.storage.AddBatch(s.ctx, &batch, dbTx)
-				if err != nil {
-					log.Errorf("networkID: %d, error storing batch. BatchNumber: %d, BlockNumber: %d, error: %w",
-						s.networkID, batch.BatchNumber, blockNumber, err)
-					rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-					if rollbackErr != nil {
-						log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error: %w",
-							s.networkID, batch.BatchNumber, blockNumber, rollbackErr.Error(), err)
-					}
-					log.Fatalf("networkID: %d, error storing batch. BatchNumber: %d, BlockNumber: %d, error: %w",
-						s.networkID, batch.BatchNumber, blockNumber, err)
-				}
-				status = true
-			} else {
-				rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-				if rollbackErr != nil {
-					log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error: %w",
-						s.networkID, batch.BatchNumber, blockNumber, rollbackErr.Error(), err)
-				}
-				log.Fatalf("networkID: %d, error checking trusted state. Error: %w", s.networkID, err)
+			if !errors.Is(err, gerror.ErrStorageNotFound) {
+				return fmt.Errorf("error checking trusted state. BatchNumber: %d, error: %w", batch.BatchNumber, err)
 			}
+			log.Debugf("NetworkID: %d, BatchNumber: %d, not found in trusted state. Storing it...", s.networkID, batch.BatchNumber)
+			// If it is not found, store batch
+			err = s.storage.AddBatch(s.ctx, &batch, dbTx)
+			if err != nil {
+				return fmt.Errorf("error storing batch. BatchNumber: %d, error: %w", batch.BatchNumber, err)
+			}
+			status = true
 		}
 		if !status {
 			// Reset trusted state
@@ -550,71 +711,36 @@ func (s *ClientSynchronizer) processSequenceBatches(sequencedBatches []etherman.
 			previousBatchNumber := batch.BatchNumber - 1
 			err := s.storage.ResetTrustedState(s.ctx, previousBatchNumber, dbTx) // This method has to reset the forced batches deleting the batchNumber for higher batchNumbers
 			if err != nil {
-				log.Errorf("networkID: %d, error resetting trusted state. BatchNumber: %d, BlockNumber: %d, error: %w",
-					s.networkID, batch.BatchNumber, blockNumber, err)
-				rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-				if rollbackErr != nil {
-					log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error: %w",
-						s.networkID, batch.BatchNumber, blockNumber, rollbackErr.Error(), err)
-				}
-				log.Fatalf("networkID: %d, error resetting trusted state. BatchNumber: %d, BlockNumber: %d, error: %w",
-					s.networkID, batch.BatchNumber, blockNumber, err)
+				return fmt.Errorf("error resetting trusted state. BatchNumber: %d, error: %w", batch.BatchNumber, err)
 			}
 			err = s.storage.AddBatch(s.ctx, &batch, dbTx)
 			if err != nil {
-				log.Errorf("networkID: %d, error storing batch. BatchNumber: %d, BlockNumber: %d, error: %w",
-					s.networkID, batch.BatchNumber, blockNumber, err)
-				rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-				if rollbackErr != nil {
-					log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error: %w",
-						s.networkID, batch.BatchNumber, blockNumber, rollbackErr.Error(), err)
-				}
-				log.Fatalf("networkID: %d, error storing batch. BatchNumber: %d, BlockNumber: %d, error: %w",
-					s.networkID, batch.BatchNumber, blockNumber, err)
+				return fmt.Errorf("error storing batch. BatchNumber: %d, error: %w", batch.BatchNumber, err)
 			}
 		}
 	}
+	return nil
 }
 
-func (s *ClientSynchronizer) processSequenceForceBatches(sequenceForceBatches []etherman.SequencedForceBatch, block etherman.Block, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processSequenceForceBatches(sequenceForceBatches []etherman.SequencedForceBatch, block etherman.Block, dbTx pgx.Tx) error {
 	if len(sequenceForceBatches) == 0 {
-		log.Error("networkID: %d, error: empty sequenceForceBatches array", s.networkID)
-		return
+		log.Errorf("networkID: %d, error: empty sequenceForceBatches array", s.networkID)
+		return nil
 	}
 	// First, reset trusted state
 	lastVirtualizedBatchNumber := sequenceForceBatches[0].BatchNumber - 1
 	err := s.storage.ResetTrustedState(s.ctx, lastVirtualizedBatchNumber, dbTx) // This method has to reset the forced batches deleting the batchNumber for higher batchNumbers
 	if err != nil {
-		log.Errorf("networkID: %d, error resetting trusted state. BatchNumber: %d, BlockNumber: %d, error: %w",
-			s.networkID, lastVirtualizedBatchNumber, block.BlockNumber, err)
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error : %w",
-				s.networkID, lastVirtualizedBatchNumber, block.BlockNumber, rollbackErr.Error(), err)
-		}
-		log.Fatalf("networkID: %d, error resetting trusted state. BatchNumber: %d, BlockNumber: %d, error: %s",
-			s.networkID, lastVirtualizedBatchNumber, block.BlockNumber, err.Error())
+		return fmt.Errorf("error resetting trusted state. BatchNumber: %d, error: %w", lastVirtualizedBatchNumber, err)
 	}
 	// Read forcedBatches from db
 	forcedBatches, err := s.storage.GetNextForcedBatches(s.ctx, len(sequenceForceBatches), dbTx)
 	if err != nil {
-		log.Errorf("networkID: %d, error getting forcedBatches in processSequenceForceBatches. BlockNumber: %d",
-			s.networkID, block.BlockNumber)
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, error : %w",
-				s.networkID, block.BlockNumber, rollbackErr.Error(), err)
-		}
-		log.Fatalf("networkID: %d, error getting forcedBatches in processSequenceForceBatches. BlockNumber: %d, error: %w",
-			s.networkID, block.BlockNumber, err)
+		return fmt.Errorf("error getting forcedBatches in processSequenceForceBatches. error: %w", err)
 	}
 
 	if len(sequenceForceBatches) != len(forcedBatches) {
-		rollbackErr := dbTx.Rollback(s.ctx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, error : %w", s.networkID, block.BlockNumber, rollbackErr.Error(), err)
-		}
-		log.Fatal("networkID: %d, error number of forced batches doesn't match", s.networkID)
+		return fmt.Errorf("error number of forced batches doesn't match. Synced: %d, stored: %d", len(sequenceForceBatches), len(forcedBatches))
 	}
 
 	for i, fbatch := range sequenceForceBatches {
@@ -622,12 +748,7 @@ func (s *ClientSynchronizer) processSequenceForceBatches(sequenceForceBatches []
 			forcedBatches[i].GlobalExitRoot != fbatch.GlobalExitRoot ||
 			common.Bytes2Hex(forcedBatches[i].RawTxsData) != common.Bytes2Hex(fbatch.Transactions) ||
 			forcedBatches[i].Sequencer != fbatch.Sequencer {
-			log.Errorf("networkID: %d, error: forcedBatch received doesn't match with the next expected forcedBatch stored in db. Expected: %+v, Synced: %+v", s.networkID, forcedBatches[i], fbatch)
-			rollbackErr := dbTx.Rollback(s.ctx)
-			if rollbackErr != nil {
-				log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %w", s.networkID, fbatch.BatchNumber, block.BlockNumber, rollbackErr)
-			}
-			log.Fatalf("networkID: %d, error: forcedBatch received doesn't match with the next expected forcedBatch stored in db. Expected: %+v, Synced: %+v", s.networkID, forcedBatches[i], fbatch)
+			return fmt.Errorf("error: forcedBatch received doesn't match with the next expected forcedBatch stored in db. Expected: %+v, Synced: %+v", forcedBatches[i], fbatch)
 		}
 		b := etherman.Batch{
 			BatchNumber:    fbatch.BatchNumber,
@@ -639,79 +760,45 @@ func (s *ClientSynchronizer) processSequenceForceBatches(sequenceForceBatches []
 		// Add batch, only store it. No need to process txs
 		err := s.storage.AddBatch(s.ctx, &b, dbTx)
 		if err != nil {
-			log.Errorf("networkID: %d, error adding batch in processSequenceForceBatches. BatchNumber: %d, BlockNumber: %d, error: %w",
-				s.networkID, b.BatchNumber, block.BlockNumber, err)
-			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-			if rollbackErr != nil {
-				log.Fatalf("networkID: %d, error rolling back state. BatchNumber: %d, BlockNumber: %d, rollbackErr: %s, error: %w",
-					s.networkID, b.BatchNumber, block.BlockNumber, rollbackErr.Error(), err)
-			}
-			log.Fatalf("networkID: %d, error processing batch in processSequenceForceBatches. BatchNumber: %d, BlockNumber: %d, error: %w",
-				s.networkID, b.BatchNumber, block.BlockNumber, err)
+			return fmt.Errorf("error adding batch in processSequenceForceBatches. BatchNumber: %d, error: %w", b.BatchNumber, err)
 		}
 		// Store batchNumber in forced_batch table
 		err = s.storage.AddBatchNumberInForcedBatch(s.ctx, forcedBatches[i].ForcedBatchNumber, b.BatchNumber, dbTx)
 		if err != nil {
-			log.Errorf("networkID: %d, error adding the batchNumber to forcedBatch in processSequenceForceBatches. BlockNumber: %d",
-				s.networkID, block.BlockNumber)
-			rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-			if rollbackErr != nil {
-				log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, error: %w",
-					s.networkID, block.BlockNumber, rollbackErr.Error(), err)
-			}
-			log.Fatalf("networkID: %d, error adding the batchNumber to forcedBatch in processSequenceForceBatches. BlockNumber: %d, error: %w",
-				s.networkID, block.BlockNumber, err)
+			return fmt.Errorf("error adding the batchNumber to forcedBatch in processSequenceForceBatches. BatchNumber: %d, error: %w", b.BatchNumber, err)
 		}
 	}
+	return nil
 }
 
-func (s *ClientSynchronizer) processForcedBatch(forcedBatch etherman.ForcedBatch, blockID uint64, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processForcedBatch(forcedBatch etherman.ForcedBatch, blockID uint64, dbTx pgx.Tx) error {
 	// Store forced batch into the db
 	forcedBatch.BlockID = blockID
 	err := s.storage.AddForcedBatch(s.ctx, &forcedBatch, dbTx)
 	if err != nil {
-		log.Errorf("networkID: %d, error storing the forcedBatch in processForcedBatch. BlockNumber: %d",
-			s.networkID, forcedBatch.BlockNumber)
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, error : %s",
-				s.networkID, forcedBatch.BlockNumber, rollbackErr.Error(), err.Error())
-		}
-		log.Fatalf("networkID: %d, error storing the forcedBatch in processForcedBatch. BlockNumber: %d, error: %s",
-			s.networkID, forcedBatch.BlockNumber, err.Error())
+		return fmt.Errorf("error storing the forcedBatch in processForcedBatch. ForcedBatchNumber: %d, error: %w", forcedBatch.ForcedBatchNumber, err)
 	}
+	return nil
 }
 
-func (s *ClientSynchronizer) processGlobalExitRoot(globalExitRoot etherman.GlobalExitRoot, blockID uint64, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processGlobalExitRoot(globalExitRoot etherman.GlobalExitRoot, blockID uint64, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error) {
 	// Store GlobalExitRoot
 	globalExitRoot.BlockID = blockID
 	err := s.storage.AddGlobalExitRoot(s.ctx, &globalExitRoot, dbTx)
 	if err != nil {
-		log.Errorf("networkID: %d, error storing the GlobalExitRoot in processGlobalExitRoot. BlockNumber: %d",
-			s.networkID, globalExitRoot.BlockNumber)
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, error : %s",
-				s.networkID, globalExitRoot.BlockNumber, rollbackErr.Error(), err.Error())
-		}
-		log.Fatalf("networkID: %d, error storing the GlobalExitRoot in processGlobalExitRoot. BlockNumber: %d, error: %s",
-			s.networkID, globalExitRoot.BlockNumber, err.Error())
+		return nil, fmt.Errorf("error storing the GlobalExitRoot in processGlobalExitRoot. GlobalExitRoot: %s, error: %w", globalExitRoot.GlobalExitRoot.String(), err)
 	}
+	return &globalExitRoot, nil
 }
 
-func (s *ClientSynchronizer) processTrustedVerifyBatch(verifiedBatch etherman.VerifiedBatch, blockID, blockNumber uint64, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processTrustedVerifyBatch(verifiedBatch etherman.VerifiedBatch, blockID, blockNumber uint64, dbTx pgx.Tx) error {
 	lastVBatch, err := s.storage.GetLastVerifiedBatch(s.ctx, dbTx)
 	if errors.Is(err, gerror.ErrStorageNotFound) {
 		lastVBatch = &etherman.VerifiedBatch{
 			BatchNumber: 0,
 		}
 	} else if err != nil {
-		log.Errorf("networkID: %d, error getting lastVerifiedBatch stored in db in processTrustedVerifyBatches. Processing synced blockNumber: %d", s.networkID, blockNumber)
-		rollbackErr := dbTx.Rollback(s.ctx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state. Processing synced blockNumber: %d, rollbackErr: %s, error : %w", s.networkID, blockNumber, rollbackErr.Error(), err)
-		}
-		log.Fatalf("networkID: %d, error getting lastVerifiedBatch stored in db in processTrustedVerifyBatches. Processing synced blockNumber: %d, error: %w", s.networkID, blockNumber, err)
+		return fmt.Errorf("error getting lastVerifiedBatch stored in db in processTrustedVerifyBatches. error: %w", err)
 	}
 	nbatches := verifiedBatch.BatchNumber - lastVBatch.BatchNumber
 	var i uint64
@@ -725,63 +812,159 @@ func (s *ClientSynchronizer) processTrustedVerifyBatch(verifiedBatch etherman.Ve
 		}
 		err = s.storage.AddVerifiedBatch(s.ctx, &verifiedB, dbTx)
 		if err != nil {
-			log.Errorf("networkID: %d, error storing the verifiedB in processTrustedVerifyBatches. verifiedBatch: %+v, verifiedBatch: %+v", s.networkID, verifiedB, verifiedBatch)
-			rollbackErr := dbTx.Rollback(s.ctx)
-			if rollbackErr != nil {
-				log.Fatalf("networkID: %d, error rolling back state. BlockNumber: %d, rollbackErr: %s, error : %w", s.networkID, blockNumber, rollbackErr.Error(), err)
-			}
-			log.Fatalf("networkID: %d, error storing the verifiedB in processTrustedVerifyBatches. BlockNumber: %d, error: %w", s.networkID, blockNumber, err)
+			return fmt.Errorf("error storing the verifiedB in processTrustedVerifyBatches. verifiedBatch: %+v, error: %w", verifiedB, err)
 		}
 	}
+	// The rollup exit tree is computed with the verified local exit root, it is removed with the block by a reorg
+	err = s.bridgeCtrl.AddVerifiedExitRoot(s.ctx, verifiedBatch.LocalExitRoot, blockID, dbTx)
+	if err != nil {
+		return fmt.Errorf("error storing the verified local exit root in processTrustedVerifyBatches. error: %w", err)
+	}
+	return nil
 }
 
-func (s *ClientSynchronizer) processDeposit(deposit etherman.Deposit, blockID uint64, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processDeposit(deposit etherman.Deposit, blockID uint64, dbTx pgx.Tx) (*etherman.Deposit, error) {
 	deposit.BlockID = blockID
 	deposit.NetworkID = s.networkID
 	err := s.storage.AddDeposit(s.ctx, &deposit, dbTx)
-	if err != nil {
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state to store block. BlockNumber: %v, rollbackErr: %s, err: %s",
-				s.networkID, deposit.BlockNumber, rollbackErr.Error(), err.Error())
-		}
-		log.Fatalf("networkID: %d, failed to store new deposit locally, BlockNumber: %d, Deposit: %+v err: %s",
-			s.networkID, deposit.BlockNumber, deposit, err.Error())
+	if err == nil {
+		err = s.storage.UpdateDepositStates(s.ctx, deposit.NetworkID, deposit.DepositCount, deposit.DepositCount+1, etherman.DepositStateIndexed, blockID, time.Time{}, dbTx)
 	}
-
-	err = s.bridgeCtrl.AddDeposit(&deposit)
 	if err != nil {
-		log.Fatalf("networkID: %d, failed to store new deposit in the bridge tree, BlockNumber: %d, Deposit: %+v err: %s",
-			s.networkID, deposit.BlockNumber, deposit, err.Error())
+		return nil, fmt.Errorf("failed to store new deposit locally, Deposit: %+v err: %w", deposit, err)
 	}
+	return &deposit, nil
 }
 
-func (s *ClientSynchronizer) processClaim(claim etherman.Claim, blockID uint64, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processClaim(claim etherman.Claim, blockID uint64, dbTx pgx.Tx) (*etherman.Claim, error) {
 	claim.BlockID = blockID
 	claim.NetworkID = s.networkID
-	err := s.storage.AddClaim(s.ctx, &claim, dbTx)
+	sourceNetwork, err := s.bridgeCtrl.ClaimSourceNetwork(s.ctx, &claim, dbTx)
+	if err == nil {
+		claim.SourceNetwork = sourceNetwork
+		err = s.storage.AddClaim(s.ctx, &claim, dbTx)
+	}
+	if err == nil {
+		err = s.storage.UpdateDepositStates(s.ctx, claim.SourceNetwork, claim.Index, claim.Index+1, etherman.DepositStateClaimed, blockID, time.Time{}, dbTx)
+	}
 	if err != nil {
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %s, err: %s",
-				s.networkID, claim.BlockNumber, rollbackErr.Error(), err.Error())
+		return nil, fmt.Errorf("error storing new Claim, Claim: %+v, err: %w", claim, err)
+	}
+	return &claim, nil
+}
+
+func (s *ClientSynchronizer) updateDepositStates(deposits []*etherman.Deposit, globalExitRoots []*etherman.GlobalExitRoot, batchVerified bool, blockID uint64, dbTx pgx.Tx) error {
+	if len(deposits) > 0 {
+		fromDepositCnt, toDepositCnt := deposits[0].DepositCount, deposits[len(deposits)-1].DepositCount+1
+		err := s.storage.UpdateDepositStates(s.ctx, s.networkID, fromDepositCnt, toDepositCnt, etherman.DepositStateIncluded, blockID, time.Time{}, dbTx)
+		if err != nil {
+			return err
+		}
+		err = s.updateLateDepositStates(deposits, blockID, dbTx)
+		if err != nil {
+			return err
+		}
+	}
+	for _, globalExitRoot := range globalExitRoots {
+		depositCnts, err := s.bridgeCtrl.ExitRootDepositCounts(s.ctx, globalExitRoot, dbTx)
+		if err != nil {
+			return err
+		}
+		for networkID, depositCnt := range depositCnts {
+			for _, state := range exitRootDepositStates(networkID, batchVerified) {
+				err = s.storage.UpdateDepositStates(s.ctx, networkID, 0, depositCnt, state, blockID, time.Time{}, dbTx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// updateLateDepositStates moves the deposits to the states of the exit roots and the claims synced before them by
+// the other synchronizers. The transitions are stored in the block of the deposits, except the claimed ones which
+// are stored in the block of the claim.
+func (s *ClientSynchronizer) updateLateDepositStates(deposits []*etherman.Deposit, blockID uint64, dbTx pgx.Tx) error {
+	fromDepositCnt := deposits[0].DepositCount
+	exitRoot, err := s.storage.GetLatestL1SyncedExitRoot(s.ctx, dbTx)
+	if err != nil && err != gerror.ErrStorageNotFound {
+		return err
+	}
+	if err == nil {
+		// The rollup exit root synced from L1 is only updated by the verification of the batches
+		if err = s.updateExitRootDepositStates(exitRoot, fromDepositCnt, exitRootDepositStates(s.networkID, true), blockID, dbTx); err != nil {
+			return err
+		}
+	}
+	if s.networkID == 0 {
+		exitRoot, err = s.storage.GetLatestTrustedExitRoot(s.ctx, dbTx)
+		if err != nil && err != gerror.ErrStorageNotFound {
+			return err
+		}
+		if err == nil {
+			states := []etherman.DepositState{etherman.DepositStateClaimable}
+			if err = s.updateExitRootDepositStates(exitRoot, fromDepositCnt, states, blockID, dbTx); err != nil {
+				return err
+			}
+		}
+	}
+	for _, deposit := range deposits {
+		claim, err := s.storage.GetClaim(s.ctx, deposit.DepositCount, deposit.DestinationNetwork, s.networkID, dbTx)
+		if err != nil {
+			if err == gerror.ErrStorageNotFound {
+				continue
+			}
+			return err
+		}
+		err = s.storage.UpdateDepositStates(s.ctx, s.networkID, deposit.DepositCount, deposit.DepositCount+1, etherman.DepositStateClaimed, claim.BlockID, time.Time{}, dbTx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateExitRootDepositStates moves the deposits of the network from fromDepositCnt which are included in the
+// global exit root to the states.
+func (s *ClientSynchronizer) updateExitRootDepositStates(globalExitRoot *etherman.GlobalExitRoot, fromDepositCnt uint, states []etherman.DepositState, blockID uint64, dbTx pgx.Tx) error {
+	depositCnts, err := s.bridgeCtrl.ExitRootDepositCounts(s.ctx, globalExitRoot, dbTx)
+	if err != nil {
+		return err
+	}
+	depositCnt, found := depositCnts[s.networkID]
+	if !found || depositCnt <= fromDepositCnt {
+		return nil
+	}
+	for _, state := range states {
+		err = s.storage.UpdateDepositStates(s.ctx, s.networkID, fromDepositCnt, depositCnt, state, blockID, time.Time{}, dbTx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exitRootDepositStates returns the states of the deposits of the network included in a global exit root synced
+// from L1. The rollup exit root is updated by the verification of the batches, and the rollup deposits are claimed
+// in L1 with it. The mainnet deposits are claimable once the exit root is in the trusted state.
+func exitRootDepositStates(networkID uint, batchVerified bool) []etherman.DepositState {
+	states := []etherman.DepositState{etherman.DepositStateExitRootOnL1}
+	if networkID != 0 {
+		if batchVerified {
+			states = append(states, etherman.DepositStateBatchVerified)
 		}
-		log.Fatalf("networkID: %d, error storing new Claim in Block:  %d, Claim: %+v, err: %s",
-			s.networkID, claim.BlockNumber, claim, err.Error())
+		states = append(states, etherman.DepositStateClaimable)
 	}
+	return states
 }
 
-func (s *ClientSynchronizer) processTokenWrapped(tokenWrapped etherman.TokenWrapped, blockID uint64, dbTx pgx.Tx) {
+func (s *ClientSynchronizer) processTokenWrapped(tokenWrapped etherman.TokenWrapped, blockID uint64, dbTx pgx.Tx) error {
 	tokenWrapped.BlockID = blockID
 	tokenWrapped.NetworkID = s.networkID
 	err := s.storage.AddTokenWrapped(s.ctx, &tokenWrapped, dbTx)
 	if err != nil {
-		rollbackErr := s.storage.Rollback(s.ctx, dbTx)
-		if rollbackErr != nil {
-			log.Fatalf("networkID: %d, error rolling back state to store block. BlockNumber: %d, rollbackErr: %s, err: %s",
-				s.networkID, tokenWrapped.BlockNumber, rollbackErr.Error(), err.Error())
-		}
-		log.Fatalf("networkID: %d, error storing new L1 TokenWrapped in Block:  %d, ExitRoot: %+v, err: %s",
-			s.networkID, tokenWrapped.BlockNumber, tokenWrapped, err.Error())
+		return fmt.Errorf("error storing new TokenWrapped, TokenWrapped: %+v, err: %w", tokenWrapped, err)
 	}
+	return nil
 }
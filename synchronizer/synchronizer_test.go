@@ -40,7 +40,7 @@ func TestTrustedStateReorg(t *testing.T) {
 		}
 		ctxMatchBy := mock.MatchedBy(func(ctx context.Context) bool { return ctx != nil })
 		m.Etherman.On("GetNetworkID", ctxMatchBy).Return(uint(0), nil)
-		sync, err := NewSynchronizer(m.Storage, m.BridgeCtrl, m.Etherman, m.BroadcastClient, genBlockNumber, cfg)
+		sync, err := NewSynchronizer(m.Storage, m.BridgeCtrl, m.Etherman, m.BroadcastClient, nil, genBlockNumber, cfg)
 		require.NoError(t, err)
 		// state preparation
 		m.Storage.
@@ -183,7 +183,32 @@ func TestTrustedStateReorg(t *testing.T) {
 				}
 
 				m.Storage.
-					On("AddTrustedGlobalExitRoot", ctx, ger, nil).
+					On("BeginDBTransaction", ctx).
+					Return(m.DbTx, nil).
+					Once()
+
+				m.Storage.
+					On("AddTrustedGlobalExitRoot", ctx, ger, m.DbTx).
+					Return(nil).
+					Once()
+
+				m.BridgeCtrl.
+					On("ExitRootDepositCounts", ctx, ger, m.DbTx).
+					Return(map[uint]uint{0: 3}, nil).
+					Once()
+
+				m.Storage.
+					On("GetFirstNotReadyDepositCount", ctx, uint(0), m.DbTx).
+					Return(uint(1), nil).
+					Once()
+
+				m.Storage.
+					On("UpdateDepositStates", ctx, uint(0), uint(1), uint(3), etherman.DepositStateClaimable, uint64(0), time.Time{}, m.DbTx).
+					Return(nil).
+					Once()
+
+				m.Storage.
+					On("Commit", ctx, m.DbTx).
 					Return(nil).
 					Once()
 			}).
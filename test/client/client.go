@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io/ioutil"
@@ -168,6 +169,51 @@ func (c RestClient) GetMerkleProof(networkID uint32, depositCnt uint64) (*pb.Pro
 	return proofResp.Proof, nil
 }
 
+// GetMerkleProofByGlobalExitRoot returns the merkle proof for the specific bridge transaction against a previous global exit root.
+func (c RestClient) GetMerkleProofByGlobalExitRoot(networkID uint32, depositCnt uint64, globalExitRoot common.Hash) (*pb.Proof, error) {
+	resp, err := http.Get(fmt.Sprintf("%s%s?net_id=%d&deposit_cnt=%d&global_exit_root=%s", c.bridgeURL, "/merkle-proof", networkID, depositCnt, globalExitRoot.String()))
+	if err != nil {
+		return nil, err
+	}
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var proofResp pb.GetProofResponse
+	err = protojson.Unmarshal(bodyBytes, &proofResp)
+	if err != nil {
+		return nil, err
+	}
+	return proofResp.Proof, nil
+}
+
+// VerifyProof verifies the merkle proof of the specific bridge transaction against the exit root.
+func (c RestClient) VerifyProof(networkID uint32, depositCnt uint64, merkleProof []string, root string) (*pb.VerifyProofResponse, error) {
+	reqBody, err := protojson.Marshal(&pb.VerifyProofRequest{
+		NetId:       networkID,
+		DepositCnt:  depositCnt,
+		MerkleProof: merkleProof,
+		Root:        root,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.Post(fmt.Sprintf("%s%s", c.bridgeURL, "/verify-proof"), "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var verifyResp pb.VerifyProofResponse
+	err = protojson.Unmarshal(bodyBytes, &verifyResp)
+	if err != nil {
+		return nil, err
+	}
+	return &verifyResp, nil
+}
+
 // GetBridge returns the specific bridge info.
 func (c RestClient) GetBridge(networkID uint32, depositCnt uint64) (*pb.Deposit, error) {
 	resp, err := http.Get(fmt.Sprintf("%s%s?net_id=%d&deposit_cnt=%d", c.bridgeURL, "/bridge", networkID, depositCnt))
@@ -186,9 +232,27 @@ func (c RestClient) GetBridge(networkID uint32, depositCnt uint64) (*pb.Deposit,
 	return bridgeResp.Deposit, nil
 }
 
-// GetWrappedToken returns the wrapped token address.
-func (c RestClient) GetWrappedToken(origNet uint32, origTokenAddr string) (*pb.TokenWrapped, error) {
-	resp, err := http.Get(fmt.Sprintf("%s%s?orig_net=%d&orig_token_addr=%s", c.bridgeURL, "/tokenwrapped", origNet, origTokenAddr))
+// GetWrappedToken returns the wrapped token address in the destination network.
+func (c RestClient) GetWrappedToken(origNet uint32, origTokenAddr string, destNet uint32) (*pb.TokenWrapped, error) {
+	resp, err := http.Get(fmt.Sprintf("%s%s?orig_net=%d&orig_token_addr=%s&dest_net=%d", c.bridgeURL, "/tokenwrapped", origNet, origTokenAddr, destNet))
+	if err != nil {
+		return nil, err
+	}
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var tokenWrappedResp pb.GetTokenWrappedResponse
+	err = protojson.Unmarshal(bodyBytes, &tokenWrappedResp)
+	if err != nil {
+		return nil, err
+	}
+	return tokenWrappedResp.Tokenwrapped, nil
+}
+
+// GetWrappedTokenByAddress returns the original token of the wrapped token address in the network.
+func (c RestClient) GetWrappedTokenByAddress(wrappedTokenAddr string, networkID uint32) (*pb.TokenWrapped, error) {
+	resp, err := http.Get(fmt.Sprintf("%s%s/%s?net_id=%d", c.bridgeURL, "/tokenwrapped-by-addr", wrappedTokenAddr, networkID))
 	if err != nil {
 		return nil, err
 	}
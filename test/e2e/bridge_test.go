@@ -202,7 +202,7 @@ func TestE2E(t *testing.T) {
 		// Get tokenWrappedAddr
 		t.Log("token Address:", tokenAddr)
 		time.Sleep(3 * time.Second) // wait for sync token_wrapped event
-		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 1, tokenAddr, true)
+		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 1, tokenAddr, 0, true)
 		require.NoError(t, err)
 		// Check L2 funds to see if the amount has been increased
 		balance2, err := opsman.CheckAccountTokenBalance(ctx, operations.L1, tokenWrapped.WrappedTokenAddress, &destAddr)
@@ -290,7 +290,7 @@ func TestE2E(t *testing.T) {
 		err = opsman.SendL2Claim(ctx, deposits[0], smtProof, globaExitRoot)
 		require.NoError(t, err)
 		time.Sleep(3 * time.Second) // wait for sync token_wrapped event
-		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 0, tokenAddr, false)
+		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 0, tokenAddr, 1, false)
 		require.NoError(t, err)
 		t.Log("TokenWrapped: ", tokenWrapped)
 		// Check L2 funds to see if the amount has been increased
@@ -398,7 +398,7 @@ func TestE2E(t *testing.T) {
 		err = opsman.SendL2Claim(ctx, deposits[2], smtProof, globaExitRoot)
 		require.NoError(t, err)
 		time.Sleep(3 * time.Second) // wait for sync token_wrapped event
-		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 0, tokenAddr, false)
+		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 0, tokenAddr, 1, false)
 		require.NoError(t, err)
 		t.Log("TokenWrapped: ", tokenWrapped)
 		// Check L2 funds to see if the amount has been increased
@@ -469,7 +469,7 @@ func TestE2E(t *testing.T) {
 		err = opsman.SendL2Claim(ctx, deposits[0], smtProof, globaExitRoot)
 		require.NoError(t, err)
 		time.Sleep(3 * time.Second) // wait for sync token_wrapped event
-		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 0, tokenAddr, false)
+		tokenWrapped, err := opsman.GetTokenWrapped(ctx, 0, tokenAddr, 1, false)
 		require.NoError(t, err)
 		balance2, err := opsman.CheckAccountTokenBalance(ctx, "l2", tokenWrapped.WrappedTokenAddress, &destAddr)
 		require.NoError(t, err)
@@ -72,7 +72,7 @@ type storageInterface interface {
 	GetLatestExitRoot(ctx context.Context, isRollup bool, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
 	GetLatestL1SyncedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
 	GetLatestTrustedExitRoot(ctx context.Context, dbTx pgx.Tx) (*etherman.GlobalExitRoot, error)
-	GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
+	GetTokenWrapped(ctx context.Context, originalNetwork uint, originalTokenAddress common.Address, networkID *uint, dbTx pgx.Tx) (*etherman.TokenWrapped, error)
 	GetDepositCountByRoot(ctx context.Context, root []byte, network uint8, dbTx pgx.Tx) (uint, error)
 	UpdateBlocksForTesting(ctx context.Context, networkID uint, blockNum uint64, dbTx pgx.Tx) error
 	GetLastBatchNumber(ctx context.Context, dbTx pgx.Tx) (uint64, error)
@@ -132,7 +132,7 @@ func NewManager(ctx context.Context, cfg *Config) (*Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	bService := bridgectrl.NewBridgeService(pgst, bt)
+	bService := bridgectrl.NewBridgeService(pgst, bt, nil)
 	opsman.storage = st.(storageInterface)
 	opsman.bridgetree = bt
 	opsman.bridgeService = bService
@@ -500,7 +500,11 @@ func (m *Manager) CheckAccountTokenBalance(ctx context.Context, network NetworkS
 
 // GetClaimData gets the claim data
 func (m *Manager) GetClaimData(networkID, depositCount uint) ([][bridgectrl.KeyLen]byte, *etherman.GlobalExitRoot, error) {
-	return m.bridgetree.GetClaim(networkID, depositCount)
+	proof, globalExitRoot, err := m.bridgetree.GetClaim(networkID, depositCount)
+	if err != nil {
+		return nil, nil, err
+	}
+	return proof.MerkleProof, globalExitRoot, nil
 }
 
 // GetBridgeInfoByDestAddr gets the bridge info
@@ -644,8 +648,8 @@ func (m *Manager) ApproveERC20(ctx context.Context, erc20Addr, bridgeAddr common
 	return client.ApproveERC20(ctx, erc20Addr, bridgeAddr, amount, auth)
 }
 
-// GetTokenWrapped get token wrapped info
-func (m *Manager) GetTokenWrapped(ctx context.Context, originNetwork uint, originalTokenAddr common.Address, isCreated bool) (*etherman.TokenWrapped, error) {
+// GetTokenWrapped get token wrapped info in the network
+func (m *Manager) GetTokenWrapped(ctx context.Context, originNetwork uint, originalTokenAddr common.Address, networkID uint, isCreated bool) (*etherman.TokenWrapped, error) {
 	if isCreated {
 		blockID, err := m.getLastBlockID(ctx)
 		if err != nil {
@@ -653,7 +657,7 @@ func (m *Manager) GetTokenWrapped(ctx context.Context, originNetwork uint, origi
 		}
 
 		err = operations.Poll(defaultInterval, defaultDeadline, func() (bool, error) {
-			wrappedToken, err := m.storage.GetTokenWrapped(ctx, originNetwork, originalTokenAddr, nil)
+			wrappedToken, err := m.storage.GetTokenWrapped(ctx, originNetwork, originalTokenAddr, &networkID, nil)
 			if err != nil {
 				return false, err
 			}
@@ -663,7 +667,7 @@ func (m *Manager) GetTokenWrapped(ctx context.Context, originNetwork uint, origi
 			return nil, err
 		}
 	}
-	return m.storage.GetTokenWrapped(ctx, originNetwork, originalTokenAddr, nil)
+	return m.storage.GetTokenWrapped(ctx, originNetwork, originalTokenAddr, &networkID, nil)
 }
 
 // UpdateBlocksForTesting updates the hash of blocks.
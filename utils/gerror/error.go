@@ -15,4 +15,25 @@ var (
 	ErrDepositNotSynced = errors.New("not synchronized deposit")
 	// ErrNetworkNotRegister is used when the networkID is not registered in the bridge
 	ErrNetworkNotRegister = errors.New("not registered network")
+	// ErrNetworkAlreadyRegistered is used when the networkID or its exit tree are already registered in the bridge
+	ErrNetworkAlreadyRegistered = errors.New("network already registered")
+	// ErrConfiguredNetwork is used when a network of the config file is deregistered at runtime
+	ErrConfiguredNetwork = errors.New("configured networks can't be deregistered")
+	// ErrExitTreesExhausted is used when a network is registered at runtime but all the exit tree indexes are used
+	ErrExitTreesExhausted = errors.New("no exit tree index left for the network")
+	// ErrExitRootNotVerified is used when a proof is requested for a rollup whose exit roots are never verified on L1,
+	// like the networks registered at runtime
+	ErrExitRootNotVerified = errors.New("exit roots of the network not verified on L1")
+	// ErrDepositNotIncluded is used when the requested exit root doesn't include the deposit
+	ErrDepositNotIncluded = errors.New("deposit not included in the exit root")
+	// ErrMissingExitRoot is used when only one of the mainnet and rollup exit roots is provided
+	ErrMissingExitRoot = errors.New("both mainnet and rollup exit roots are required")
+	// ErrInvalidFilter is used when a filter of the request has an invalid value
+	ErrInvalidFilter = errors.New("invalid filter")
+	// ErrInvalidCursor is used when the pagination cursor of the request can't be decoded
+	ErrInvalidCursor = errors.New("invalid cursor")
+	// ErrInvalidTxHash is used when the transaction hash of the request isn't a 32 bytes hex string
+	ErrInvalidTxHash = errors.New("invalid transaction hash")
+	// ErrSlowSubscriber is used when a subscription is closed because the subscriber doesn't read the events
+	ErrSlowSubscriber = errors.New("subscriber too slow to receive the events")
 )
@@ -0,0 +1,21 @@
+package webhook
+
+import (
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+)
+
+// Config is the config of the webhook deliveries
+type Config struct {
+	// Workers is the number of deliveries sent concurrently. 0 disables the webhooks
+	Workers int
+	// PollInterval is the interval to look for the deliveries whose attempt is due
+	PollInterval types.Duration
+	// Timeout is the timeout of the requests to the subscribers
+	Timeout types.Duration
+	// MaxAttempts is the number of attempts to send a delivery before moving it to the dead letters
+	MaxAttempts uint
+	// InitialBackoff is the delay of the first retry, it is doubled in each retry up to MaxBackoff
+	InitialBackoff types.Duration
+	// MaxBackoff is the maximum delay between the retries
+	MaxBackoff types.Duration
+}
@@ -0,0 +1,321 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	// secretLen is the number of random bytes of the generated secrets
+	secretLen = 32
+	// maxResponseLen is the number of bytes of the responses of the subscribers which are read
+	maxResponseLen = 1024
+	// eventPageSize is the number of events moved to the deliveries in a db transaction
+	eventPageSize = 100
+)
+
+// stateEvents are the types of the events sent for the transitions of the deposits to the states. The transitions
+// to the rest of the states aren't sent.
+var stateEvents = map[etherman.DepositState]bridgectrl.DepositEventType{
+	etherman.DepositStateIndexed:   bridgectrl.DepositIndexed,
+	etherman.DepositStateClaimable: bridgectrl.DepositReadyForClaim,
+	etherman.DepositStateClaimed:   bridgectrl.DepositClaimed,
+	etherman.DepositStateReorged:   bridgectrl.DepositRemoved,
+}
+
+// eventNames are the names of the event types in the payloads.
+var eventNames = map[bridgectrl.DepositEventType]string{
+	bridgectrl.DepositIndexed:       "indexed",
+	bridgectrl.DepositReadyForClaim: "ready_for_claim",
+	bridgectrl.DepositClaimed:       "claimed",
+	bridgectrl.DepositRemoved:       "removed",
+}
+
+// payload is the JSON body of the deliveries.
+type payload struct {
+	Event          string         `json:"event"`
+	SubscriptionID uint64         `json:"subscription_id"`
+	Deposit        depositPayload `json:"deposit"`
+	ClaimTxHash    string         `json:"claim_tx_hash,omitempty"`
+}
+
+type depositPayload struct {
+	LeafType   uint8  `json:"leaf_type"`
+	OrigNet    uint   `json:"orig_net"`
+	OrigAddr   string `json:"orig_addr"`
+	Amount     string `json:"amount"`
+	DestNet    uint   `json:"dest_net"`
+	DestAddr   string `json:"dest_addr"`
+	BlockNum   uint64 `json:"block_num"`
+	DepositCnt uint   `json:"deposit_cnt"`
+	NetworkID  uint   `json:"network_id"`
+	TxHash     string `json:"tx_hash"`
+	Metadata   string `json:"metadata"`
+	SenderAddr string `json:"sender_addr"`
+}
+
+// Dispatcher sends the deposit events to the webhook subscriptions. The events are stored by the synchronizers with
+// the transitions of the deposits, the dispatcher moves them to the deliveries of the matching subscriptions, which
+// are sent by the workers until they succeed or run out of attempts.
+type Dispatcher struct {
+	cfg     Config
+	storage storageInterface
+	client  *http.Client
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(cfg Config, storage interface{}) *Dispatcher {
+	return &Dispatcher{
+		cfg:     cfg,
+		storage: storage.(storageInterface),
+		client:  &http.Client{Timeout: cfg.Timeout.Duration},
+	}
+}
+
+// AddSubscription stores a webhook subscription. A random secret is generated if it is empty.
+func (d *Dispatcher) AddSubscription(ctx context.Context, sub *etherman.WebhookSubscription) error {
+	if sub.Secret == "" {
+		secret := make([]byte, secretLen)
+		if _, err := rand.Read(secret); err != nil {
+			return err
+		}
+		sub.Secret = hex.EncodeToString(secret)
+	}
+	id, err := d.storage.AddWebhookSubscription(ctx, sub, nil)
+	if err != nil {
+		return err
+	}
+	sub.ID = id
+	sub.CreatedAt = time.Now()
+	return nil
+}
+
+// DeleteSubscription removes a webhook subscription with its pending deliveries.
+func (d *Dispatcher) DeleteSubscription(ctx context.Context, id uint64) error {
+	return d.storage.DeleteWebhookSubscription(ctx, id, nil)
+}
+
+// GetSubscriptions returns the webhook subscriptions.
+func (d *Dispatcher) GetSubscriptions(ctx context.Context) ([]*etherman.WebhookSubscription, error) {
+	return d.storage.GetWebhookSubscriptions(ctx, nil)
+}
+
+// GetDeadLetters returns the deliveries of the subscription which failed all the attempts.
+func (d *Dispatcher) GetDeadLetters(ctx context.Context, subscriptionID *uint64, limit uint, offset uint) ([]*etherman.WebhookDelivery, error) {
+	return d.storage.GetWebhookDeadLetters(ctx, subscriptionID, limit, offset, nil)
+}
+
+// ReplayDeadLetters sends again the dead letters of the subscription with the ids, with all their attempts.
+func (d *Dispatcher) ReplayDeadLetters(ctx context.Context, subscriptionID *uint64, ids []uint64) (uint64, error) {
+	return d.storage.ReplayWebhookDeadLetters(ctx, subscriptionID, ids, time.Now(), nil)
+}
+
+// DispatchEvents moves the stored deposit events to the deliveries of the matching subscriptions, until there are no
+// more. Each page of events is moved in a db transaction, so the events are neither lost nor delivered twice.
+func (d *Dispatcher) DispatchEvents(ctx context.Context) error {
+	for {
+		count, err := d.dispatchEvents(ctx)
+		if err != nil {
+			return err
+		}
+		if count < eventPageSize {
+			return nil
+		}
+	}
+}
+
+func (d *Dispatcher) dispatchEvents(ctx context.Context) (int, error) {
+	dbTx, err := d.storage.BeginDBTransaction(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count, err := d.addDeliveries(ctx, dbTx)
+	if err != nil {
+		rollbackErr := d.storage.Rollback(ctx, dbTx)
+		if rollbackErr != nil {
+			log.Errorf("error rolling back the webhook events. RollbackErr: %s, err: %s", rollbackErr.Error(), err.Error())
+		}
+		return 0, err
+	}
+	return count, d.storage.Commit(ctx, dbTx)
+}
+
+// addDeliveries stores the deliveries of a page of events and removes the events, inside dbTx.
+func (d *Dispatcher) addDeliveries(ctx context.Context, dbTx pgx.Tx) (int, error) {
+	events, err := d.storage.GetWebhookEvents(ctx, eventPageSize, dbTx)
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	now := time.Now()
+	ids := make([]uint64, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+		eventType, found := stateEvents[e.State]
+		if !found {
+			continue
+		}
+		event := &bridgectrl.DepositEvent{Type: eventType, Deposit: e.Deposit, ClaimTxHash: e.ClaimTxHash}
+		deposit := e.Deposit
+		subs, err := d.storage.GetMatchingWebhookSubscriptions(ctx, deposit.DestinationAddress, deposit.DestinationNetwork, uint8(eventType), dbTx)
+		if err != nil {
+			return 0, err
+		}
+		for _, sub := range subs {
+			body, err := json.Marshal(newPayload(sub.ID, event))
+			if err != nil {
+				return 0, err
+			}
+			delivery := &etherman.WebhookDelivery{
+				SubscriptionID: sub.ID,
+				EventType:      uint8(eventType),
+				Payload:        body,
+				NextAttemptAt:  now,
+			}
+			if err := d.storage.AddWebhookDelivery(ctx, delivery, dbTx); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(events), d.storage.DeleteWebhookEvents(ctx, ids, dbTx)
+}
+
+func newPayload(subscriptionID uint64, event *bridgectrl.DepositEvent) *payload {
+	deposit := event.Deposit
+	p := &payload{
+		Event:          eventNames[event.Type],
+		SubscriptionID: subscriptionID,
+		Deposit: depositPayload{
+			LeafType:   deposit.LeafType,
+			OrigNet:    deposit.OriginalNetwork,
+			OrigAddr:   deposit.OriginalAddress.Hex(),
+			Amount:     deposit.Amount.String(),
+			DestNet:    deposit.DestinationNetwork,
+			DestAddr:   deposit.DestinationAddress.Hex(),
+			BlockNum:   deposit.BlockNumber,
+			DepositCnt: deposit.DepositCount,
+			NetworkID:  deposit.NetworkID,
+			TxHash:     deposit.TxHash.Hex(),
+			Metadata:   "0x" + hex.EncodeToString(deposit.Metadata),
+			SenderAddr: deposit.Sender.Hex(),
+		},
+	}
+	if event.ClaimTxHash != (common.Hash{}) {
+		p.ClaimTxHash = event.ClaimTxHash.Hex()
+	}
+	return p
+}
+
+// Start moves the stored events to the deliveries and sends the due deliveries periodically until the context is
+// done.
+func (d *Dispatcher) Start(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("webhook dispatcher ctx done")
+			return
+		case <-time.After(d.cfg.PollInterval.Duration):
+			if err := d.DispatchEvents(ctx); err != nil {
+				log.Warn("error storing the webhook deliveries: ", err)
+			}
+			if err := d.Dispatch(ctx); err != nil {
+				log.Warn("error sending the webhook deliveries: ", err)
+			}
+		}
+	}
+}
+
+// Dispatch sends the deliveries whose attempt is due, until there are no more.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	for {
+		now := time.Now()
+		// The deliveries are leased for longer than the requests, so they aren't sent twice at the same time
+		deliveries, err := d.storage.LeaseWebhookDeliveries(ctx, now, now.Add(2*d.cfg.Timeout.Duration), uint(d.cfg.Workers), nil) //nolint:gomnd
+		if err != nil {
+			return err
+		}
+		var (
+			wg   sync.WaitGroup
+			errs = make([]error, len(deliveries))
+		)
+		for i, delivery := range deliveries {
+			wg.Add(1)
+			go func(i int, delivery *etherman.WebhookDelivery) {
+				defer wg.Done()
+				errs[i] = d.deliver(ctx, delivery)
+			}(i, delivery)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
+		if len(deliveries) < d.cfg.Workers {
+			return nil
+		}
+	}
+}
+
+// deliver sends a delivery and records the result of the attempt.
+func (d *Dispatcher) deliver(ctx context.Context, delivery *etherman.WebhookDelivery) error {
+	err := d.send(ctx, delivery)
+	if err == nil {
+		return d.storage.DeleteWebhookDelivery(ctx, delivery.ID, nil)
+	}
+	if delivery.Attempts+1 >= d.cfg.MaxAttempts {
+		log.Warnf("webhook delivery %d to subscription %d failed %d times, moved to the dead letters. Error: %s", delivery.ID, delivery.SubscriptionID, delivery.Attempts+1, err.Error())
+		return d.storage.DeadLetterWebhookDelivery(ctx, delivery.ID, err.Error(), nil)
+	}
+	log.Debugf("webhook delivery %d to subscription %d failed, retrying. Error: %s", delivery.ID, delivery.SubscriptionID, err.Error())
+	return d.storage.RetryWebhookDelivery(ctx, delivery.ID, time.Now().Add(d.backoff(delivery.Attempts)), err.Error(), nil)
+}
+
+// send posts the payload of the delivery to the url of its subscription. Any response other than 2xx is an error.
+func (d *Dispatcher) send(ctx context.Context, delivery *etherman.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, signatureHeader(delivery.Secret, time.Now().Unix(), delivery.Payload))
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(delivery.ID, 10)) //nolint:gomnd
+	req.Header.Set(EventHeader, eventNames[bridgectrl.DepositEventType(delivery.EventType)])
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxResponseLen))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// backoff returns the delay of the retry after the failed attempts.
+func (d *Dispatcher) backoff(attempts uint) time.Duration {
+	delay := d.cfg.InitialBackoff.Duration
+	for i := uint(0); i < attempts && delay < d.cfg.MaxBackoff.Duration; i++ {
+		delay *= 2
+	}
+	if delay > d.cfg.MaxBackoff.Duration {
+		delay = d.cfg.MaxBackoff.Duration
+	}
+	return delay
+}
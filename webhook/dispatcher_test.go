@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/bridgectrl"
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/0xPolygonHermez/zkevm-node/config/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testTx is the db transaction passed to the storage mock.
+type testTx struct {
+	pgx.Tx
+}
+
+func newTestDispatcher(t *testing.T) (*Dispatcher, *storageMock) {
+	m := newStorageMock(t)
+	cfg := Config{
+		Workers:        2,
+		Timeout:        types.Duration{Duration: time.Second},
+		MaxAttempts:    3,
+		InitialBackoff: types.Duration{Duration: time.Second},
+		MaxBackoff:     types.Duration{Duration: 3 * time.Second},
+	}
+	return NewDispatcher(cfg, m), m
+}
+
+func TestDispatchEvents(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDispatcher(t)
+	deposit := &etherman.Deposit{
+		OriginalAddress:    common.HexToAddress("0x02"),
+		Amount:             big.NewInt(100),
+		DestinationNetwork: 1,
+		DestinationAddress: common.HexToAddress("0x01"),
+		DepositCount:       5,
+		Metadata:           []byte{1},
+	}
+	events := []*etherman.WebhookEvent{
+		{ID: 1, State: etherman.DepositStateClaimed, Deposit: deposit, ClaimTxHash: common.HexToHash("0x03")},
+		// The transitions to the states without events are removed without deliveries
+		{ID: 2, State: etherman.DepositStateIncluded, Deposit: deposit},
+	}
+
+	dbTx := &testTx{}
+	m.On("BeginDBTransaction", ctx).Return(dbTx, nil).Once()
+	m.On("GetWebhookEvents", ctx, uint(eventPageSize), dbTx).Return(events, nil).Once()
+	m.On("GetMatchingWebhookSubscriptions", ctx, deposit.DestinationAddress, uint(1), uint8(bridgectrl.DepositClaimed), dbTx).
+		Return([]*etherman.WebhookSubscription{{ID: 7}}, nil).Once()
+	m.On("AddWebhookDelivery", ctx, mock.MatchedBy(func(delivery *etherman.WebhookDelivery) bool {
+		var p payload
+		require.NoError(t, json.Unmarshal(delivery.Payload, &p))
+		return delivery.SubscriptionID == 7 && p.Event == "claimed" && p.SubscriptionID == 7 && p.Deposit.DepositCnt == 5 &&
+			p.Deposit.Amount == "100" && p.Deposit.Metadata == "0x01" && p.ClaimTxHash == events[0].ClaimTxHash.Hex()
+	}), dbTx).Return(nil).Once()
+	m.On("DeleteWebhookEvents", ctx, []uint64{1, 2}, dbTx).Return(nil).Once()
+	m.On("Commit", ctx, dbTx).Return(nil).Once()
+	require.NoError(t, d.DispatchEvents(ctx))
+
+	// The events are kept if their deliveries can't be stored
+	m.On("BeginDBTransaction", ctx).Return(dbTx, nil).Once()
+	m.On("GetWebhookEvents", ctx, uint(eventPageSize), dbTx).Return(events[:1], nil).Once()
+	m.On("GetMatchingWebhookSubscriptions", ctx, deposit.DestinationAddress, uint(1), uint8(bridgectrl.DepositClaimed), dbTx).
+		Return(nil, errors.New("db error")).Once()
+	m.On("Rollback", ctx, dbTx).Return(nil).Once()
+	require.Error(t, d.DispatchEvents(ctx))
+}
+
+func TestDispatch(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDispatcher(t)
+
+	received := make(chan *http.Request, 3)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		// The receiver checks the signature with the secret of the subscription
+		parts := strings.Split(r.Header.Get(SignatureHeader), ",")
+		require.Len(t, parts, 2)
+		timestamp, err := strconv.ParseInt(strings.TrimPrefix(parts[0], "t="), 10, 64)
+		require.NoError(t, err)
+		require.Equal(t, "v1="+Signature("secret", timestamp, body), parts[1])
+		received <- r
+		if string(body) == "fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer receiver.Close()
+
+	deliveries := []*etherman.WebhookDelivery{
+		{ID: 1, EventType: uint8(bridgectrl.DepositReadyForClaim), Payload: []byte("ok"), URL: receiver.URL, Secret: "secret"},
+		{ID: 2, EventType: uint8(bridgectrl.DepositReadyForClaim), Payload: []byte("fail"), Attempts: 1, URL: receiver.URL, Secret: "secret"},
+	}
+	m.On("LeaseWebhookDeliveries", ctx, mock.Anything, mock.Anything, uint(2), nil).Return(deliveries, nil).Once()
+	m.On("LeaseWebhookDeliveries", ctx, mock.Anything, mock.Anything, uint(2), nil).Return([]*etherman.WebhookDelivery{
+		{ID: 2, Payload: []byte("fail"), Attempts: 2, URL: receiver.URL, Secret: "secret"},
+	}, nil).Once()
+	m.On("DeleteWebhookDelivery", ctx, uint64(1), nil).Return(nil)
+	m.On("RetryWebhookDelivery", ctx, uint64(2), mock.MatchedBy(func(nextAttemptAt time.Time) bool {
+		return time.Until(nextAttemptAt) > time.Second
+	}), mock.Anything, nil).Return(nil)
+	// The last attempt moves the delivery to the dead letters
+	m.On("DeadLetterWebhookDelivery", ctx, uint64(2), mock.Anything, nil).Return(nil)
+	require.NoError(t, d.Dispatch(ctx))
+
+	require.Len(t, received, 3)
+	r := <-received
+	require.Equal(t, "ready_for_claim", r.Header.Get(EventHeader))
+}
+
+func TestBackoff(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	require.Equal(t, time.Second, d.backoff(0))
+	require.Equal(t, 2*time.Second, d.backoff(1))
+	require.Equal(t, 3*time.Second, d.backoff(2))
+	require.Equal(t, 3*time.Second, d.backoff(100))
+}
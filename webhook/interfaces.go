@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-bridge-service/etherman"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+type storageInterface interface {
+	BeginDBTransaction(ctx context.Context) (pgx.Tx, error)
+	Commit(ctx context.Context, dbTx pgx.Tx) error
+	Rollback(ctx context.Context, dbTx pgx.Tx) error
+	AddWebhookSubscription(ctx context.Context, sub *etherman.WebhookSubscription, dbTx pgx.Tx) (uint64, error)
+	DeleteWebhookSubscription(ctx context.Context, id uint64, dbTx pgx.Tx) error
+	GetWebhookSubscriptions(ctx context.Context, dbTx pgx.Tx) ([]*etherman.WebhookSubscription, error)
+	GetMatchingWebhookSubscriptions(ctx context.Context, address common.Address, networkID uint, eventType uint8, dbTx pgx.Tx) ([]*etherman.WebhookSubscription, error)
+	GetWebhookEvents(ctx context.Context, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookEvent, error)
+	DeleteWebhookEvents(ctx context.Context, ids []uint64, dbTx pgx.Tx) error
+	AddWebhookDelivery(ctx context.Context, delivery *etherman.WebhookDelivery, dbTx pgx.Tx) error
+	LeaseWebhookDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit uint, dbTx pgx.Tx) ([]*etherman.WebhookDelivery, error)
+	DeleteWebhookDelivery(ctx context.Context, id uint64, dbTx pgx.Tx) error
+	RetryWebhookDelivery(ctx context.Context, id uint64, nextAttemptAt time.Time, lastError string, dbTx pgx.Tx) error
+	DeadLetterWebhookDelivery(ctx context.Context, id uint64, lastError string, dbTx pgx.Tx) error
+	GetWebhookDeadLetters(ctx context.Context, subscriptionID *uint64, limit uint, offset uint, dbTx pgx.Tx) ([]*etherman.WebhookDelivery, error)
+	ReplayWebhookDeadLetters(ctx context.Context, subscriptionID *uint64, ids []uint64, nextAttemptAt time.Time, dbTx pgx.Tx) (uint64, error)
+}
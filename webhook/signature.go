@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// SignatureHeader is the header of the signature of the deliveries, in the format "t=<timestamp>,v1=<signature>"
+	SignatureHeader = "X-Bridge-Signature"
+	// DeliveryHeader is the header of the id of the delivery, it is kept in the retries and replays so the
+	// subscribers can discard the duplicated deliveries
+	DeliveryHeader = "X-Bridge-Delivery"
+	// EventHeader is the header of the event type of the delivery
+	EventHeader = "X-Bridge-Event"
+)
+
+// Signature returns the hex encoded HMAC-SHA256 of the timestamp in unix seconds and the body joined by a dot, with
+// the secret of the subscription. The timestamp lets the subscribers reject the old requests.
+func Signature(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10))) //nolint:gomnd
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// signatureHeader returns the value of the signature header.
+func signatureHeader(secret string, timestamp int64, body []byte) string {
+	return "t=" + strconv.FormatInt(timestamp, 10) + ",v1=" + Signature(secret, timestamp, body) //nolint:gomnd
+}